@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -14,6 +15,8 @@ type Client struct {
 	BaseURL    string
 	Token      string
 	HTTPClient *http.Client
+
+	limiter rateLimiter
 }
 
 // NewClient creates a new API client
@@ -29,27 +32,50 @@ func NewClient(baseURL, token string) *Client {
 
 // doRequest performs an HTTP request with authentication
 func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	var bodyReader io.Reader
+	return c.doConditionalRequest(method, path, body, nil)
+}
+
+// doConditionalRequest performs an HTTP request that only succeeds if the
+// precondition (if any) still holds on the server. Rate-limited requests are
+// retried after the delay the server asks for.
+func (c *Client) doConditionalRequest(method, path string, body interface{}, cond *Precondition) (*http.Response, error) {
+	var data []byte
 	if body != nil {
-		data, err := json.Marshal(body)
+		var err error
+		data, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequest(method, c.BaseURL+path, bodyReader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if data != nil {
+			bodyReader = bytes.NewReader(data)
+		}
 
-	if c.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.Token)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+		req, err := http.NewRequest(method, c.BaseURL+path, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		if c.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Token)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		cond.apply(req)
+
+		c.limiter.wait()
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxRateLimitRetries {
+			return resp, err
+		}
 
-	return c.HTTPClient.Do(req)
+		c.limiter.pause(retryAfter(resp, attempt))
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
 }
 
 // GetBrand fetches the active brand DNA
@@ -97,20 +123,20 @@ func (c *Client) GetBrandVersions() ([]BrandDNA, error) {
 }
 
 // GetTasks fetches tasks with optional filters
-func (c *Client) GetTasks(status, platform string) ([]Task, error) {
+func (c *Client) GetTasks(filter TaskFilter) ([]Task, error) {
 	path := "/api/v1/tasks"
-	query := ""
-	if status != "" {
-		query += "status=" + status
+	query := url.Values{}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
 	}
-	if platform != "" {
-		if query != "" {
-			query += "&"
-		}
-		query += "platform=" + platform
+	if filter.Platform != "" {
+		query.Set("platform", filter.Platform)
+	}
+	if filter.Discarded {
+		query.Set("discarded", "true")
 	}
-	if query != "" {
-		path += "?" + query
+	if len(query) > 0 {
+		path += "?" + query.Encode()
 	}
 
 	resp, err := c.doRequest("GET", path, nil)
@@ -151,6 +177,7 @@ func (c *Client) GetTask(id int) (*Task, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	task.ETag = resp.Header.Get("ETag")
 
 	return &task, nil
 }
@@ -188,13 +215,78 @@ func (c *Client) CreateTask(req CreateTaskRequest) (*Task, error) {
 	return &task, nil
 }
 
-// UpdateTask updates an existing task
-func (c *Client) UpdateTask(id int, req UpdateTaskRequest) (*Task, error) {
+// UpdateTask updates an existing task. If cond is non-nil, the update is
+// only applied if the task hasn't changed since it was read; otherwise a
+// *ConflictError is returned.
+func (c *Client) UpdateTask(id int, req UpdateTaskRequest, cond *Precondition) (*Task, error) {
 	body := map[string]interface{}{
 		"task": req,
 	}
 
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/tasks/%d", id), body)
+	resp, err := c.doConditionalRequest("PATCH", fmt.Sprintf("/api/v1/tasks/%d", id), body, cond)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task not found")
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return nil, c.conflictError(id, resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var task Task
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	task.ETag = resp.Header.Get("ETag")
+
+	return &task, nil
+}
+
+// DiscardTask soft-deletes a task. If cond is non-nil, the task is only
+// discarded if it hasn't changed since it was read.
+func (c *Client) DiscardTask(id int, cond *Precondition) (*DiscardResponse, error) {
+	resp, err := c.doConditionalRequest("DELETE", fmt.Sprintf("/api/v1/tasks/%d", id), nil, cond)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task not found")
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return nil, c.conflictError(id, resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result DiscardResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// MoveTask moves a task to a position in its status group. Positions start
+// at 1; the server shifts the other tasks to make room.
+func (c *Client) MoveTask(id, position int) (*Task, error) {
+	body := map[string]interface{}{
+		"position": position,
+	}
+
+	resp, err := c.doRequest("PATCH", fmt.Sprintf("/api/v1/tasks/%d/position", id), body)
 	if err != nil {
 		return nil, err
 	}
@@ -212,13 +304,69 @@ func (c *Client) UpdateTask(id int, req UpdateTaskRequest) (*Task, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	task.ETag = resp.Header.Get("ETag")
+
+	return &task, nil
+}
+
+// ReorderTasks sets the order of tasks: the first ID gets position 1, the
+// next 2, and so on. Returns the reordered tasks.
+func (c *Client) ReorderTasks(ids []int) ([]Task, error) {
+	body := map[string]interface{}{
+		"task_ids": ids,
+	}
+
+	resp, err := c.doRequest("PUT", "/api/v1/tasks/order", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result TasksResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return result.Tasks, nil
+}
+
+// SetCopy adds content as a new copy variant and makes it the task's
+// selected copy. Returns the updated task.
+func (c *Client) SetCopy(id int, content string) (*Task, error) {
+	body := map[string]interface{}{
+		"content": content,
+	}
+
+	resp, err := c.doRequest("POST", fmt.Sprintf("/api/v1/tasks/%d/copy", id), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task not found")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var task Task
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	task.ETag = resp.Header.Get("ETag")
 
 	return &task, nil
 }
 
-// DiscardTask soft-deletes a task
-func (c *Client) DiscardTask(id int) (*DiscardResponse, error) {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/tasks/%d", id), nil)
+// ListCopies returns all copy variants written for a task
+func (c *Client) ListCopies(id int) ([]CopyVariant, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/api/v1/tasks/%d/copies", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +380,86 @@ func (c *Client) DiscardTask(id int) (*DiscardResponse, error) {
 		return nil, c.parseError(resp)
 	}
 
+	var result CopiesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return result.Copies, nil
+}
+
+// SelectCopy makes an existing copy variant the task's selected copy.
+// Returns the updated task.
+func (c *Client) SelectCopy(id, copyID int) (*Task, error) {
+	body := map[string]interface{}{
+		"copy_id": copyID,
+	}
+
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/api/v1/tasks/%d/copy", id), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task or copy not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var task Task
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	task.ETag = resp.Header.Get("ETag")
+
+	return &task, nil
+}
+
+// RestoreTask restores a discarded task
+func (c *Client) RestoreTask(id int) (*Task, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/api/v1/tasks/%d/restore", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("discarded task not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var task Task
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	task.ETag = resp.Header.Get("ETag")
+
+	return &task, nil
+}
+
+// PurgeTask permanently deletes a discarded task. It shares its endpoint
+// with DiscardTask, so callers must check that the task is discarded first.
+func (c *Client) PurgeTask(id int) (*DiscardResponse, error) {
+	resp, err := c.doRequest("DELETE", fmt.Sprintf("/api/v1/tasks/%d?purge=true", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("discarded task not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
 	var result DiscardResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
@@ -260,6 +488,44 @@ func (c *Client) GetContext() (*ContextResponse, error) {
 	return &ctx, nil
 }
 
+// GetCurrentUser fetches the organization and user the token belongs to
+func (c *Client) GetCurrentUser() (*CurrentUserResponse, error) {
+	resp, err := c.doRequest("GET", "/api/v1/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var me CurrentUserResponse
+	if err := json.NewDecoder(resp.Body).Decode(&me); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &me, nil
+}
+
+// conflictError builds a *ConflictError from a 412 response, fetching the
+// current version of the task if the response doesn't include it
+func (c *Client) conflictError(id int, resp *http.Response) error {
+	var body struct {
+		Task *Task `json:"task"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+
+	current := body.Task
+	if current != nil {
+		current.ETag = resp.Header.Get("ETag")
+	} else {
+		current, _ = c.GetTask(id)
+	}
+
+	return &ConflictError{TaskID: id, Current: current}
+}
+
 func (c *Client) parseError(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
 
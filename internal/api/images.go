@@ -0,0 +1,148 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// transferTimeout bounds image uploads and downloads, which can take much
+// longer than API calls
+const transferTimeout = 10 * time.Minute
+
+// ListImages returns the images attached to a task
+func (c *Client) ListImages(id int) ([]TaskImage, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/api/v1/tasks/%d/images", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result ImagesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return result.Images, nil
+}
+
+// DownloadImage opens an image's file for reading. The caller must close it.
+// The API token is only sent when the file is served by the API itself, not
+// to storage hosts behind signed URLs.
+func (c *Client) DownloadImage(image TaskImage) (io.ReadCloser, error) {
+	target, err := c.resolveURL(image.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image URL: %w", err)
+	}
+
+	req, err := http.NewRequest("GET", target.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if base, err := url.Parse(c.BaseURL); err == nil && base.Host == target.Host && c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	c.limiter.wait()
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, c.parseError(resp)
+	}
+	return resp.Body, nil
+}
+
+// UploadImage attaches an image to a task as a multipart upload. size must
+// be the exact number of bytes body will produce.
+func (c *Client) UploadImage(id int, filename, contentType string, body io.Reader, size int64) (*TaskImage, error) {
+	// Build the multipart envelope around the file so the request has a
+	// known length and the file is streamed rather than held in memory
+	var head bytes.Buffer
+	mw := multipart.NewWriter(&head)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="image"; filename="%s"`, escapeQuotes(filename)))
+	header.Set("Content-Type", contentType)
+	if _, err := mw.CreatePart(header); err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	tail := "\r\n--" + mw.Boundary() + "--\r\n"
+
+	req, err := http.NewRequest("POST", c.BaseURL+fmt.Sprintf("/api/v1/tasks/%d/images", id),
+		io.MultiReader(&head, body, strings.NewReader(tail)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = int64(head.Len()) + size + int64(len(tail))
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+
+	c.limiter.wait()
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task not found")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var image TaskImage
+	if err := json.NewDecoder(resp.Body).Decode(&image); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &image, nil
+}
+
+// transferClient is the client's HTTP client with a timeout suited to file
+// transfers
+func (c *Client) transferClient() *http.Client {
+	client := *c.HTTPClient
+	client.Timeout = transferTimeout
+	return &client
+}
+
+// resolveURL resolves a URL from the API, which may be relative to it
+func (c *Client) resolveURL(ref string) (*url.URL, error) {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return nil, err
+	}
+	return base.ResolveReference(u), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
@@ -0,0 +1,46 @@
+package api
+
+import "encoding/json"
+
+// Optional is a request field with three states: unset (the zero value,
+// omitted from the request), null (clears the field on the server) and a
+// value. Plain pointers with omitempty can't express "clear".
+type Optional[T any] struct {
+	set   bool
+	null  bool
+	value T
+}
+
+// Set returns an Optional holding v
+func Set[T any](v T) Optional[T] {
+	return Optional[T]{set: true, value: v}
+}
+
+// Null returns an Optional that clears the field
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// IsSet reports whether the field will be sent, as a value or as null
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether the field will be sent as null
+func (o Optional[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// Get returns the value and whether there is one (false if unset or null)
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// MarshalJSON encodes the value, or null if the field is null or unset.
+// Structs containing Optionals must omit unset fields themselves.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if value, ok := o.Get(); ok {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Precondition makes a write conditional on the resource being unchanged
+// since it was read. ETag is preferred; UnmodifiedSince is the fallback
+// when only the task's UpdatedAt is known (e.g. from a task list).
+type Precondition struct {
+	ETag            string
+	UnmodifiedSince time.Time
+}
+
+// PreconditionFor returns the precondition for writing a task that was read
+// as task
+func PreconditionFor(task *Task) *Precondition {
+	return &Precondition{ETag: task.ETag, UnmodifiedSince: task.UpdatedAt}
+}
+
+// apply sets the conditional request headers. A nil Precondition sets none.
+func (p *Precondition) apply(req *http.Request) {
+	if p == nil {
+		return
+	}
+	if p.ETag != "" {
+		req.Header.Set("If-Match", p.ETag)
+	} else if !p.UnmodifiedSince.IsZero() {
+		// HTTP dates have second resolution. Truncate rather than round up,
+		// which would let through a change made up to a second later.
+		since := p.UnmodifiedSince.UTC().Truncate(time.Second)
+		req.Header.Set("If-Unmodified-Since", since.Format(http.TimeFormat))
+	}
+}
+
+// ConflictError is returned when a conditional write fails because the task
+// was changed on the server since it was read (HTTP 412)
+type ConflictError struct {
+	TaskID  int
+	Current *Task // The server's current version, if it could be fetched
+}
+
+func (e *ConflictError) Error() string {
+	if e.Current != nil {
+		return fmt.Sprintf("task #%d was changed by someone else (server version updated at %s)",
+			e.TaskID, e.Current.UpdatedAt.Local().Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("task #%d was changed by someone else", e.TaskID)
+}
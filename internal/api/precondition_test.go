@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPreconditionApply(t *testing.T) {
+	updated := time.Date(2026, 10, 18, 9, 30, 15, 600_000_000, time.UTC)
+
+	tests := []struct {
+		name                       string
+		cond                       *Precondition
+		ifMatch, ifUnmodifiedSince string
+	}{
+		{"none", nil, "", ""},
+		{"etag preferred", &Precondition{ETag: `"v1"`, UnmodifiedSince: updated}, `"v1"`, ""},
+		{"truncated date", &Precondition{UnmodifiedSince: updated}, "", "Sun, 18 Oct 2026 09:30:15 GMT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("PATCH", "http://example.com/api/v1/tasks/1", nil)
+			tt.cond.apply(req)
+			if got := req.Header.Get("If-Match"); got != tt.ifMatch {
+				t.Errorf("If-Match = %q, want %q", got, tt.ifMatch)
+			}
+			if got := req.Header.Get("If-Unmodified-Since"); got != tt.ifUnmodifiedSince {
+				t.Errorf("If-Unmodified-Since = %q, want %q", got, tt.ifUnmodifiedSince)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Retry behaviour for rate-limited (429) responses
+const (
+	maxRateLimitRetries = 5
+	defaultRetryAfter   = time.Second
+	maxRetryAfter       = time.Minute
+)
+
+// rateLimiter pauses every request made through a client once the server
+// says it is rate limited, so concurrent callers back off together instead of
+// each hammering the API until they are told to wait
+type rateLimiter struct {
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// wait blocks until any pause imposed by the server has passed
+func (l *rateLimiter) wait() {
+	l.mu.Lock()
+	until := l.pausedUntil
+	l.mu.Unlock()
+
+	if d := time.Until(until); d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// pause holds back all requests for d
+func (l *rateLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited
+// request, from the Retry-After header (seconds or an HTTP date) or an
+// exponential backoff when the server doesn't say
+func retryAfter(resp *http.Response, attempt int) time.Duration {
+	d := defaultRetryAfter << attempt
+	if header := resp.Header.Get("Retry-After"); header != "" {
+		if secs, err := strconv.Atoi(header); err == nil {
+			d = time.Duration(secs) * time.Second
+		} else if at, err := http.ParseTime(header); err == nil {
+			d = time.Until(at)
+		}
+	}
+
+	if d < 0 {
+		d = 0
+	}
+	if d > maxRetryAfter {
+		d = maxRetryAfter
+	}
+	return d
+}
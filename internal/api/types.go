@@ -1,6 +1,9 @@
 package api
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // DeviceCodeResponse is returned when requesting a device code
 type DeviceCodeResponse struct {
@@ -38,6 +41,12 @@ type UserInfo struct {
 	Email string `json:"email"`
 }
 
+// CurrentUserResponse is returned from /api/v1/me
+type CurrentUserResponse struct {
+	Organization OrganizationInfo `json:"organization"`
+	User         UserInfo         `json:"user"`
+}
+
 // BrandDNA represents the brand DNA from the API
 type BrandDNA struct {
 	ID          int                    `json:"id"`
@@ -74,6 +83,10 @@ type Task struct {
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
 
+	// ETag is the version identifier from the response headers, when the
+	// API sent one. Used for conditional updates.
+	ETag string `json:"-"`
+
 	// Detailed fields (only in single task response)
 	ImagePrompt        string          `json:"image_prompt,omitempty"`
 	CopyPrompt         string          `json:"copy_prompt,omitempty"`
@@ -82,6 +95,8 @@ type Task struct {
 	SelectedCopy       *SelectedCopy   `json:"selected_copy,omitempty"`
 	ReadyForCompletion bool            `json:"ready_for_completion,omitempty"`
 	GithubEventID      *int            `json:"github_event_id,omitempty"`
+	PublishedURL       string          `json:"published_url,omitempty"`
+	PublishedAt        *time.Time      `json:"published_at,omitempty"`
 }
 
 // SelectedCopy represents selected copy for a task
@@ -90,6 +105,44 @@ type SelectedCopy struct {
 	Content string `json:"content"`
 }
 
+// CopyVariant is one piece of copy written for a task. At most one variant
+// is selected; it's the task's SelectedCopy.
+type CopyVariant struct {
+	ID        int       `json:"id"`
+	Content   string    `json:"content"`
+	Selected  bool      `json:"selected"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CopiesResponse is returned from /api/v1/tasks/:id/copies
+type CopiesResponse struct {
+	Copies []CopyVariant `json:"copies"`
+}
+
+// TaskImage is an image attached to a task
+type TaskImage struct {
+	ID          int       `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	ByteSize    int64     `json:"byte_size"`
+	Checksum    string    `json:"checksum"` // Base64-encoded MD5 of the file
+	URL         string    `json:"url"`
+	Selected    bool      `json:"selected"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ImagesResponse is returned from /api/v1/tasks/:id/images
+type ImagesResponse struct {
+	Images []TaskImage `json:"images"`
+}
+
+// TaskFilter narrows the tasks returned by GetTasks
+type TaskFilter struct {
+	Status    string
+	Platform  string
+	Discarded bool // List soft-deleted tasks instead of active ones
+}
+
 // TasksResponse is returned from /api/v1/tasks
 type TasksResponse struct {
 	Tasks []Task `json:"tasks"`
@@ -150,11 +203,64 @@ type TaskSummary struct {
 	CopyPrompt        string  `json:"copy_prompt,omitempty"`
 }
 
-// UpdateTaskRequest represents the request body for updating a task
+// UpdateTaskRequest represents the request body for updating a task.
+// Fields left as the zero Optional are not sent and stay unchanged on the
+// server; Null clears a field.
 type UpdateTaskRequest struct {
-	Title       string `json:"title,omitempty"`
-	Description string `json:"description,omitempty"`
-	Status      string `json:"status,omitempty"`
+	Title             Optional[string]
+	Description       Optional[string]
+	Status            Optional[string]
+	Platform          Optional[string]
+	AssetType         Optional[string]
+	SuggestedPostDate Optional[string]
+	ImagePrompt       Optional[string]
+	CopyPrompt        Optional[string]
+	VideoPrompt       Optional[string]
+	PublishedURL      Optional[string]
+	PublishedAt       Optional[string] // RFC 3339 timestamp
+}
+
+// Fields returns the request's fields keyed by their JSON name
+func (r UpdateTaskRequest) Fields() map[string]Optional[string] {
+	return map[string]Optional[string]{
+		"title":               r.Title,
+		"description":         r.Description,
+		"status":              r.Status,
+		"platform":            r.Platform,
+		"asset_type":          r.AssetType,
+		"suggested_post_date": r.SuggestedPostDate,
+		"image_prompt":        r.ImagePrompt,
+		"copy_prompt":         r.CopyPrompt,
+		"video_prompt":        r.VideoPrompt,
+		"published_url":       r.PublishedURL,
+		"published_at":        r.PublishedAt,
+	}
+}
+
+// IsEmpty reports whether the request would change nothing
+func (r UpdateTaskRequest) IsEmpty() bool {
+	for _, f := range r.Fields() {
+		if f.IsSet() {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON sends only the fields that are set, with null for cleared ones
+func (r UpdateTaskRequest) MarshalJSON() ([]byte, error) {
+	body := make(map[string]interface{})
+	for name, f := range r.Fields() {
+		if !f.IsSet() {
+			continue
+		}
+		if value, ok := f.Get(); ok {
+			body[name] = value
+		} else {
+			body[name] = nil
+		}
+	}
+	return json.Marshal(body)
 }
 
 // DiscardResponse represents the response from discarding a resource
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateTaskRequestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  UpdateTaskRequest
+		want string
+	}{
+		{
+			name: "absent fields are omitted",
+			req:  UpdateTaskRequest{},
+			want: `{}`,
+		},
+		{
+			name: "null clears a field",
+			req:  UpdateTaskRequest{Description: Null[string]()},
+			want: `{"description":null}`,
+		},
+		{
+			name: "value sets a field",
+			req:  UpdateTaskRequest{Platform: Set("linkedin")},
+			want: `{"platform":"linkedin"}`,
+		},
+		{
+			name: "empty string is a value, not null",
+			req:  UpdateTaskRequest{Description: Set("")},
+			want: `{"description":""}`,
+		},
+		{
+			name: "absent, null and value together",
+			req: UpdateTaskRequest{
+				SuggestedPostDate: Null[string](),
+				Description:       Null[string](),
+				Platform:          Set("twitter"),
+			},
+			want: `{"description":null,"platform":"twitter","suggested_post_date":null}`,
+		},
+		{
+			name: "every field",
+			req: UpdateTaskRequest{
+				Title:             Set("Launch"),
+				Description:       Set("Announce v2"),
+				Status:            Set("in_progress"),
+				Platform:          Set("blog"),
+				AssetType:         Set("copy"),
+				SuggestedPostDate: Set("2026-11-02"),
+				ImagePrompt:       Null[string](),
+				CopyPrompt:        Null[string](),
+				VideoPrompt:       Null[string](),
+				PublishedURL:      Set("https://example.com/launch"),
+				PublishedAt:       Set("2026-11-02T09:00:00Z"),
+			},
+			want: `{"asset_type":"copy","copy_prompt":null,"description":"Announce v2",` +
+				`"image_prompt":null,"platform":"blog","published_at":"2026-11-02T09:00:00Z",` +
+				`"published_url":"https://example.com/launch","status":"in_progress",` +
+				`"suggested_post_date":"2026-11-02","title":"Launch","video_prompt":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal =\n  %s\nwant\n  %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskRequestIsEmpty(t *testing.T) {
+	if !(UpdateTaskRequest{}).IsEmpty() {
+		t.Error("zero request is not empty")
+	}
+	if (UpdateTaskRequest{SuggestedPostDate: Null[string]()}).IsEmpty() {
+		t.Error("request clearing a field is empty")
+	}
+}
+
+func TestOptionalStates(t *testing.T) {
+	tests := []struct {
+		name      string
+		opt       Optional[string]
+		wantSet   bool
+		wantNull  bool
+		wantValue string
+		wantOK    bool
+	}{
+		{name: "unset", opt: Optional[string]{}},
+		{name: "null", opt: Null[string](), wantSet: true, wantNull: true},
+		{name: "value", opt: Set("x"), wantSet: true, wantValue: "x", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opt.IsSet(); got != tt.wantSet {
+				t.Errorf("IsSet = %v, want %v", got, tt.wantSet)
+			}
+			if got := tt.opt.IsNull(); got != tt.wantNull {
+				t.Errorf("IsNull = %v, want %v", got, tt.wantNull)
+			}
+			value, ok := tt.opt.Get()
+			if value != tt.wantValue || ok != tt.wantOK {
+				t.Errorf("Get = %q, %v, want %q, %v", value, ok, tt.wantValue, tt.wantOK)
+			}
+		})
+	}
+}
@@ -25,7 +25,7 @@ func runBrand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
+	client := api.NewClient(account.APIURL, account.Token)
 	brand, err := client.GetBrand()
 	if err != nil {
 		return fmt.Errorf("failed to fetch brand: %w", err)
@@ -37,11 +37,45 @@ func runBrand(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	overrides := currentLocalOverrides()
+
 	if jsonOutput {
-		return outputJSON(brand)
+		return outputJSON(struct {
+			*api.BrandDNA
+			LocalOverrides *localOverrides `json:"local_overrides,omitempty"`
+		}{brand, overrides})
+	}
+
+	if err := outputBrandText(brand); err != nil {
+		return err
 	}
+	if overrides != nil {
+		fmt.Println()
+		fmt.Printf("Local Overrides (from %s):\n", overrides.Source)
+		for _, addendum := range overrides.VoiceAddenda {
+			fmt.Printf("  - %s\n", addendum)
+		}
+	}
+
+	return nil
+}
+
+// localOverrides are project-specific additions from .ygm.yml. They are
+// output separately from the API data so it's clear they are local.
+type localOverrides struct {
+	VoiceAddenda []string `json:"voice_addenda"`
+	Source       string   `json:"source"`
+}
 
-	return outputBrandText(brand)
+// currentLocalOverrides returns the local overrides for this project, or nil
+func currentLocalOverrides() *localOverrides {
+	if localCfg == nil || len(localCfg.Voice.Addenda) == 0 {
+		return nil
+	}
+	return &localOverrides{
+		VoiceAddenda: localCfg.Voice.Addenda,
+		Source:       localCfg.Source("voice.addenda"),
+	}
 }
 
 func outputBrandText(brand *api.BrandDNA) error {
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	configGlobalFlag  bool
+	configLocalFlag   bool
+	configShowSecrets bool
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Get and set CLI configuration",
+	Long: `Read and change ygm settings without hand-editing YAML.
+
+Settings live in two files:
+  --global   ~/.config/ygm/config.yml (accounts, default org, API URL)
+  --local    .ygm.yml (project-specific settings, safe to commit)
+
+Without --global or --local, list and get show the effective value of each
+setting together with where it came from (flag, env, local, global or
+default). Tokens are masked unless --show-secrets is given.
+
+Subcommands:
+  list      List settings
+  get       Print a single setting
+  set       Change a setting
+  unset     Remove a setting, reverting to its default
+  edit      Open a config file in $VISUAL or $EDITOR
+  path      Print config file locations`,
+	Example: `  ygm config list
+  ygm config get org
+  ygm config set default_org acme-corp
+  ygm config set --local org acme-corp
+  ygm config set accounts.acme-staging.api_url https://staging.example.com
+  ygm config edit --global`,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List settings and where they come from",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigList,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of a setting",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigGet,
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the location of the config files",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigPath,
+}
+
+func init() {
+	configCmd.PersistentFlags().BoolVar(&configGlobalFlag, "global", false, "Use the global config (~/.config/ygm/config.yml)")
+	configCmd.PersistentFlags().BoolVar(&configLocalFlag, "local", false, "Use the project config (.ygm.yml)")
+	configCmd.PersistentFlags().BoolVar(&configShowSecrets, "show-secrets", false, "Print tokens instead of masking them")
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configPathCmd)
+}
+
+// configSetting is a setting value together with where it came from
+type configSetting struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+	File   string `json:"file,omitempty"` // .ygm.yml layer for local settings
+}
+
+// configScope returns the scope selected by --global/--local, or "" for
+// the effective (merged) view
+func configScope() (config.Scope, error) {
+	switch {
+	case configGlobalFlag && configLocalFlag:
+		return "", fmt.Errorf("--global and --local are mutually exclusive")
+	case configGlobalFlag:
+		return config.ScopeGlobal, nil
+	case configLocalFlag:
+		return config.ScopeLocal, nil
+	}
+	return "", nil
+}
+
+// collectSettings returns the settings visible in the given scope
+func collectSettings(scope config.Scope) []configSetting {
+	switch scope {
+	case config.ScopeGlobal:
+		if cfg == nil {
+			return nil
+		}
+		return settingsFrom(cfg.Settings(), sourceGlobal, nil)
+	case config.ScopeLocal:
+		if localCfg == nil {
+			return nil
+		}
+		return withLocalFiles(settingsFrom(localCfg.Settings(), sourceLocal, nil))
+	}
+
+	// Effective view: the values commands will actually use
+	var settings []configSetting
+	org, orgSource := resolveOrg()
+	if org != "" {
+		settings = append(settings, configSetting{Key: "org", Value: org, Source: orgSource})
+	}
+
+	var account config.Account
+	if cfg != nil {
+		account = cfg.Accounts[org]
+	}
+	apiURL, apiURLSource := resolveAPIURL(account)
+	settings = append(settings, configSetting{Key: "api_url", Value: apiURL, Source: apiURLSource})
+
+	shadowed := map[string]bool{"org": true, "api_url": true}
+	if cfg != nil {
+		settings = append(settings, settingsFrom(cfg.Settings(), sourceGlobal, shadowed)...)
+	}
+	if localCfg != nil {
+		settings = append(settings, settingsFrom(localCfg.Settings(), sourceLocal, shadowed)...)
+	}
+
+	return withLocalFiles(settings)
+}
+
+// withLocalFiles records which .ygm.yml layer each local setting came from
+func withLocalFiles(settings []configSetting) []configSetting {
+	if localCfg == nil {
+		return settings
+	}
+	for i := range settings {
+		if settings[i].Source == sourceLocal {
+			settings[i].File = localCfg.Source(settings[i].Key)
+		}
+	}
+	return settings
+}
+
+// settingsFrom converts a settings map to a sorted list, skipping keys in skip
+func settingsFrom(values map[string]string, source string, skip map[string]bool) []configSetting {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if !skip[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	settings := make([]configSetting, 0, len(keys))
+	for _, key := range keys {
+		settings = append(settings, configSetting{
+			Key:    key,
+			Value:  maskSecret(key, values[key]),
+			Source: source,
+		})
+	}
+	return settings
+}
+
+// maskSecret hides secret values unless --show-secrets was given
+func maskSecret(key, value string) string {
+	if configShowSecrets || value == "" {
+		return value
+	}
+	if k, err := config.LookupKey(key); err == nil && k.Secret {
+		return "********"
+	}
+	return value
+}
+
+func runConfigList(cmd *cobra.Command, args []string) error {
+	scope, err := configScope()
+	if err != nil {
+		return err
+	}
+
+	settings := collectSettings(scope)
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"settings": settings})
+	}
+
+	if len(settings) == 0 {
+		fmt.Println("No settings found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+	for _, s := range settings {
+		source := s.Source
+		if s.File != "" {
+			source += " (" + s.File + ")"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Key, s.Value, source)
+	}
+	return w.Flush()
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	key := args[0]
+	if _, err := config.LookupKey(key); err != nil {
+		return err
+	}
+
+	scope, err := configScope()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range collectSettings(scope) {
+		if s.Key != key {
+			continue
+		}
+		if jsonOutput {
+			return outputJSON(s)
+		}
+		fmt.Println(s.Value)
+		return nil
+	}
+
+	return fmt.Errorf("'%s' is not set", key)
+}
+
+func runConfigPath(cmd *cobra.Command, args []string) error {
+	scope, err := configScope()
+	if err != nil {
+		return err
+	}
+
+	globalPath, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	localPath, err := localConfigTarget()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		paths := map[string]string{}
+		if scope != config.ScopeLocal {
+			paths["global"] = globalPath
+		}
+		if scope != config.ScopeGlobal {
+			paths["local"] = localPath
+		}
+		return outputJSON(paths)
+	}
+
+	switch scope {
+	case config.ScopeGlobal:
+		fmt.Println(globalPath)
+	case config.ScopeLocal:
+		fmt.Println(localPath)
+	default:
+		fmt.Printf("Global: %s\n", globalPath)
+		fmt.Printf("Local:  %s\n", localPath)
+	}
+	return nil
+}
+
+// localConfigTarget returns the .ygm.yml in use, or the one that would be
+// created in the current directory if the project isn't linked yet
+func localConfigTarget() (string, error) {
+	path, err := config.LocalConfigPath()
+	if err != nil {
+		return "", err
+	}
+	if path != "" {
+		return path, nil
+	}
+	return filepath.Abs(config.LocalConfigFile)
+}
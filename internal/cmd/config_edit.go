@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open a config file in your editor",
+	Long: `Open the global config (default) or, with --local, the project's .ygm.yml
+in $VISUAL or $EDITOR.
+
+The edited file is validated before it replaces the original. If it is
+invalid, nothing is changed and your edits are kept in a temporary file.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigEdit,
+}
+
+func runConfigEdit(cmd *cobra.Command, args []string) error {
+	scope, err := configScope()
+	if err != nil {
+		return err
+	}
+
+	var path string
+	if scope == config.ScopeLocal {
+		path, err = config.LocalConfigPath()
+		if err == nil && path == "" {
+			return fmt.Errorf("no %s found. Run 'ygm link' first.", config.LocalConfigFile)
+		}
+	} else {
+		path, err = config.ConfigPath()
+	}
+	if err != nil {
+		return err
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no config at %s. Run 'ygm login' first.", path)
+		}
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	// Edit a copy so a half-finished or invalid edit never reaches the real file
+	tmp, err := os.CreateTemp("", "ygm-config-*.yml")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, err = tmp.Write(original)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := openEditor(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to read edited config: %w", err)
+	}
+	if bytes.Equal(edited, original) {
+		os.Remove(tmpPath)
+		fmt.Println("No changes.")
+		return nil
+	}
+
+	if scope == config.ScopeLocal {
+		_, err = config.ParseLocal(path, edited)
+	} else {
+		_, err = config.Parse(edited)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid config, %s not changed (your edits are in %s): %w", path, tmpPath, err)
+	}
+
+	// Save under the config lock, and only if nothing else changed
+	// the file while the editor was open
+	if err := replaceConfigFile(path, original, edited, scope); err != nil {
+		return fmt.Errorf("%s not changed (your edits are in %s): %w", path, tmpPath, err)
+	}
+
+	os.Remove(tmpPath)
+	fmt.Printf("Saved %s\n", path)
+	return nil
+}
+
+// replaceConfigFile writes edited over path if it still holds original
+func replaceConfigFile(path string, original, edited []byte, scope config.Scope) error {
+	lock, err := config.LockFile(path)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	current, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if !bytes.Equal(current, original) {
+		return fmt.Errorf("it was changed while you were editing")
+	}
+
+	// The global config holds tokens, so only its owner may read it
+	perm := os.FileMode(0600)
+	if scope == config.ScopeLocal {
+		perm = 0644
+	}
+	if err := config.WriteFileAtomic(path, edited, perm); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting",
+	Long: `Change a setting in the global or local config.
+
+The file is chosen from the key (default_org and api_url are global, org is
+local); --global or --local can be given to be explicit.
+
+Examples:
+  ygm config set default_org acme-corp
+  ygm config set api_url https://staging.youvegotmarketing.com
+  ygm config set --local org acme-corp`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a setting, reverting to its default",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigUnset,
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+	return changeSetting(key, func(global *config.Config, local *config.LocalConfig) error {
+		if global != nil {
+			return global.Set(key, value)
+		}
+		if key == "org" && cfg != nil {
+			if _, ok := cfg.Accounts[value]; !ok {
+				return fmt.Errorf("organization '%s' not found. Run 'ygm login' to add it.", value)
+			}
+		}
+		return local.Set(key, value)
+	})
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	key := args[0]
+	return changeSetting(key, func(global *config.Config, local *config.LocalConfig) error {
+		if global != nil {
+			return global.Unset(key)
+		}
+		return local.Unset(key)
+	})
+}
+
+// changeSetting applies fn to the config file that holds key. Exactly one of
+// global and local is non-nil when fn is called.
+func changeSetting(key string, fn func(global *config.Config, local *config.LocalConfig) error) error {
+	k, err := config.LookupKey(key)
+	if err != nil {
+		return err
+	}
+
+	scope, err := configScope()
+	if err != nil {
+		return err
+	}
+	if scope == "" {
+		scope = k.Scope
+	}
+	if scope != k.Scope {
+		return fmt.Errorf("'%s' is a %s setting and can't be changed with --%s", key, k.Scope, scope)
+	}
+
+	if scope == config.ScopeGlobal {
+		if _, err := config.Update(func(c *config.Config) error {
+			return fn(c, nil)
+		}); err != nil {
+			return err
+		}
+		path, _ := config.ConfigPath()
+		fmt.Printf("Updated %s in %s\n", key, path)
+		return nil
+	}
+
+	path, err := localConfigTarget()
+	if err != nil {
+		return err
+	}
+	// Change only the nearest layer, never values inherited from parents
+	local, err := config.UpdateLocalFile(path, func(local *config.LocalConfig) error {
+		return fn(nil, local)
+	})
+	if err != nil {
+		return err
+	}
+	if local.IsEmpty() {
+		fmt.Printf("Updated %s; removed %s since nothing was left in it\n", key, path)
+		return nil
+	}
+	fmt.Printf("Updated %s in %s\n", key, path)
+	return nil
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/api"
 	"github.com/spf13/cobra"
@@ -14,22 +16,62 @@ var contextCmd = &cobra.Command{
 
 This includes your brand DNA, marketing plan, and pending tasks
 in a JSON format that can be included in prompts for AI tools
-like Claude, ChatGPT, or GitHub Copilot.`,
+like Claude, ChatGPT, or GitHub Copilot.
+
+Projects can limit the sections with context.sections in .ygm.yml
+(organization, brand, marketing_plan, tasks). Voice addenda from
+voice.addenda in .ygm.yml are included under local_overrides.`,
 	RunE: runContext,
 }
 
+// contextOutput is the context as printed, limited to the project's
+// sections and with local overrides added
+type contextOutput struct {
+	Organization   *api.ContextOrganization  `json:"organization,omitempty"`
+	Brand          *api.ContextBrand         `json:"brand,omitempty"`
+	MarketingPlan  *api.ContextMarketingPlan `json:"marketing_plan,omitempty"`
+	Tasks          *api.ContextTasks         `json:"tasks,omitempty"`
+	LocalOverrides *localOverrides           `json:"local_overrides,omitempty"`
+	GeneratedAt    time.Time                 `json:"generated_at"`
+}
+
 func runContext(cmd *cobra.Command, args []string) error {
 	account, err := getActiveAccount()
 	if err != nil {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
+	client := api.NewClient(account.APIURL, account.Token)
 	ctx, err := client.GetContext()
 	if err != nil {
 		return fmt.Errorf("failed to fetch context: %w", err)
 	}
 
+	var sections []string
+	if localCfg != nil {
+		sections = localCfg.Context.Sections
+	}
+	include := func(section string) bool {
+		return len(sections) == 0 || slices.Contains(sections, section)
+	}
+
+	out := contextOutput{
+		LocalOverrides: currentLocalOverrides(),
+		GeneratedAt:    ctx.GeneratedAt,
+	}
+	if include("organization") {
+		out.Organization = &ctx.Organization
+	}
+	if include("brand") {
+		out.Brand = ctx.Brand
+	}
+	if include("marketing_plan") {
+		out.MarketingPlan = ctx.MarketingPlan
+	}
+	if include("tasks") {
+		out.Tasks = &ctx.Tasks
+	}
+
 	// Context always outputs JSON (it's designed for machine consumption)
-	return outputJSON(ctx)
+	return outputJSON(out)
 }
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// editorCommand returns the user's preferred editor from $VISUAL or $EDITOR
+func editorCommand() string {
+	if editor := os.Getenv("VISUAL"); editor != "" {
+		return editor
+	}
+	if editor := os.Getenv("EDITOR"); editor != "" {
+		return editor
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// openEditor opens path in the user's editor and waits for it to exit
+func openEditor(path string) error {
+	parts := strings.Fields(editorCommand())
+	editor := exec.Command(parts[0], append(parts[1:], path)...)
+	editor.Stdin = os.Stdin
+	editor.Stdout = os.Stdout
+	editor.Stderr = os.Stderr
+
+	if err := editor.Run(); err != nil {
+		return fmt.Errorf("editor %s failed: %w", parts[0], err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/hooks"
+	"github.com/spf13/cobra"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Suggest tasks from your commits with git hooks",
+	Long: `Install git hooks that watch this repository for marketing-relevant
+commits and queue a suggested task for each one. Review, edit and create
+the suggestions with 'ygm tasks suggestions'.
+
+A commit is picked up when its Conventional Commit type is one of
+hooks.types (default feat) or it has one of hooks.trailers (default
+"Marketing: yes"). A watched trailer set to "no" keeps a commit out, e.g.
+"Marketing: no" on a feat: commit. Set both per project in .ygm.yml:
+
+  hooks:
+    types: [feat, perf]
+    trailers: ["Marketing: yes"]
+
+Nothing is sent anywhere until you create the suggested tasks.
+
+Subcommands:
+  install    Install the post-commit and/or pre-push hook
+  uninstall  Remove the hooks, restoring any they replaced
+  status     Show which hooks are installed`,
+}
+
+var hooksStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which hooks are installed",
+	Long: `Show whether ygm's post-commit and pre-push hooks are installed in this
+repository, whether they run a hook they replaced, and how many
+suggestions are waiting for review.`,
+	Args: cobra.NoArgs,
+	RunE: runHooksStatus,
+}
+
+func init() {
+	hooksCmd.AddCommand(hooksInstallCmd)
+	hooksCmd.AddCommand(hooksUninstallCmd)
+	hooksCmd.AddCommand(hooksStatusCmd)
+	hooksCmd.AddCommand(hooksRunCmd)
+}
+
+func runHooksStatus(cmd *cobra.Command, args []string) error {
+	dir, err := hooks.Dir(".")
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]hooks.Status, 0, len(hooks.Supported))
+	for _, hook := range hooks.Supported {
+		s, err := hooks.Check(dir, hook)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, s)
+	}
+
+	queue, err := hooks.LoadQueue(".")
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"hooks":       statuses,
+			"suggestions": len(queue.Suggestions),
+			"rules":       hookRules(),
+		})
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  HOOK\tSTATUS")
+	for _, s := range statuses {
+		marker := " "
+		if s.Installed {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%s %s\t%s\n", marker, s.Hook, hookStatusLabel(s))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	n := len(queue.Suggestions)
+	fmt.Printf("\n%d %s queued", n, plural(n, "suggestion", "suggestions"))
+	if n > 0 {
+		fmt.Print("; review with 'ygm tasks suggestions'")
+	}
+	fmt.Println()
+	return nil
+}
+
+// hookStatusLabel describes a hook's state in the status table
+func hookStatusLabel(s hooks.Status) string {
+	switch {
+	case s.Installed && s.Chained != "":
+		return "installed (also runs the previous hook)"
+	case s.Installed:
+		return "installed"
+	case s.Foreign:
+		return "not installed (another hook is in place)"
+	default:
+		return "not installed"
+	}
+}
+
+// hookRules are the project's rules for picking up commits
+func hookRules() hooks.Rules {
+	if localCfg == nil {
+		return hooks.NewRules(nil, nil)
+	}
+	return hooks.NewRules(localCfg.Hooks.Types, localCfg.Hooks.Trailers)
+}
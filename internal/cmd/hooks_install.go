@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/hooks"
+	"github.com/spf13/cobra"
+)
+
+var hooksInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the post-commit and/or pre-push hook",
+	Long: `Install ygm's git hooks in this repository.
+
+The post-commit hook looks at each commit as you make it. The pre-push hook
+looks at the commits you're about to push instead, which is quieter if you
+commit often and rewrite history before pushing. Install either or both.
+
+A hook that's already in place is kept: it's renamed to <hook>.ygm-chained
+and run before ygm's, and its exit status is kept, so a failing pre-push
+check still stops the push. ygm itself never fails a commit or push.
+Running install again updates ygm's hooks in place.
+
+Examples:
+  ygm hooks install
+  ygm hooks install --hook pre-push
+  ygm hooks install --hook post-commit,pre-push`,
+	Args: cobra.NoArgs,
+	RunE: runHooksInstall,
+}
+
+var hooksUninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Remove the hooks, restoring any they replaced",
+	Long: `Remove ygm's git hooks from this repository and put back the hooks they
+replaced. Hooks ygm didn't install are left alone. Queued suggestions are
+kept; drop them with 'ygm tasks suggestions drop'.
+
+Examples:
+  ygm hooks uninstall
+  ygm hooks uninstall --hook pre-push`,
+	Args: cobra.NoArgs,
+	RunE: runHooksUninstall,
+}
+
+var (
+	hooksInstallNames   []string
+	hooksUninstallNames []string
+)
+
+func init() {
+	hooksInstallCmd.Flags().StringSliceVar(&hooksInstallNames, "hook", []string{"post-commit"}, "Hooks to install (post-commit, pre-push)")
+	hooksUninstallCmd.Flags().StringSliceVar(&hooksUninstallNames, "hook", hooks.Supported, "Hooks to remove (post-commit, pre-push)")
+}
+
+func runHooksInstall(cmd *cobra.Command, args []string) error {
+	if err := validateHookNames(hooksInstallNames); err != nil {
+		return err
+	}
+	dir, err := hooks.Dir(".")
+	if err != nil {
+		return err
+	}
+
+	// Hooks run without the user's shell setup, so call this binary directly
+	// rather than relying on PATH
+	executable, err := os.Executable()
+	if err != nil {
+		executable = "ygm"
+	}
+
+	statuses := make([]hooks.Status, 0, len(hooksInstallNames))
+	for _, hook := range hooksInstallNames {
+		s, err := hooks.Install(dir, hook, executable)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, s)
+		if !jsonOutput {
+			fmt.Printf("Installed %s hook in %s\n", hook, s.Path)
+			if s.Chained != "" {
+				fmt.Printf("  The previous %s hook was moved to %s and still runs first\n", hook, s.Chained)
+			}
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"hooks": statuses, "rules": hookRules()})
+	}
+	rules := hookRules()
+	fmt.Printf("\nCommits of type %s or with trailer %s will be queued for 'ygm tasks suggestions'.\n",
+		joinQuoted(rules.Types, " or "), joinQuoted(rules.Trailers, " or "))
+	return nil
+}
+
+func runHooksUninstall(cmd *cobra.Command, args []string) error {
+	if err := validateHookNames(hooksUninstallNames); err != nil {
+		return err
+	}
+	dir, err := hooks.Dir(".")
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]hooks.Status, 0, len(hooksUninstallNames))
+	for _, hook := range hooksUninstallNames {
+		before, err := hooks.Check(dir, hook)
+		if err != nil {
+			return err
+		}
+		if !before.Installed {
+			statuses = append(statuses, before)
+			if jsonOutput {
+				continue
+			}
+			if before.Foreign {
+				fmt.Printf("The %s hook wasn't installed by ygm; leaving it alone\n", hook)
+			} else {
+				fmt.Printf("The %s hook isn't installed\n", hook)
+			}
+			continue
+		}
+
+		s, err := hooks.Uninstall(dir, hook)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, s)
+		if !jsonOutput {
+			fmt.Printf("Removed %s hook\n", hook)
+			if before.Chained != "" {
+				fmt.Printf("  Restored the previous %s hook\n", hook)
+			}
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"hooks": statuses})
+	}
+	return nil
+}
+
+func validateHookNames(names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("no hooks given")
+	}
+	for _, name := range names {
+		if !slices.Contains(hooks.Supported, name) {
+			return fmt.Errorf("unsupported hook %q (expected post-commit or pre-push)", name)
+		}
+	}
+	return nil
+}
+
+// joinQuoted quotes each item and joins them with sep
+func joinQuoted(items []string, sep string) string {
+	quoted := ""
+	for i, item := range items {
+		if i > 0 {
+			quoted += sep
+		}
+		quoted += fmt.Sprintf("%q", item)
+	}
+	return quoted
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/hooks"
+	"github.com/spf13/cobra"
+)
+
+// hooksRunCmd is what the installed hooks call. It's hidden because it's
+// only meant to be run by git.
+var hooksRunCmd = &cobra.Command{
+	Use:          "run <hook> [args...]",
+	Short:        "Queue suggestions for new commits (run by the git hooks)",
+	Hidden:       true,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE:         runHooksRun,
+}
+
+func runHooksRun(cmd *cobra.Command, args []string) error {
+	var commits []hooks.Commit
+	var err error
+	switch args[0] {
+	case "post-commit":
+		commits, err = hooks.ReadCommits(".", "HEAD^!")
+	case "pre-push":
+		commits, err = hooks.PushedCommits(".", os.Stdin)
+	default:
+		return fmt.Errorf("unsupported hook %q", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	rules := hookRules()
+	var found []hooks.Suggestion
+	for _, c := range commits {
+		if reason, ok := rules.Match(c); ok {
+			found = append(found, hooks.Suggest(c, reason))
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+
+	added := 0
+	queue, err := hooks.UpdateQueue(".", func(q *hooks.Queue) error {
+		// Oldest first, so the queue reads in commit order
+		for i := len(found) - 1; i >= 0; i-- {
+			if q.Add(found[i]) {
+				added++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if added > 0 {
+		fmt.Fprintf(os.Stderr, "ygm: queued %d task %s (%d waiting); review with 'ygm tasks suggestions'\n",
+			added, plural(added, "suggestion", "suggestions"), len(queue.Suggestions))
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/config"
 	"github.com/CromulentConsulting/ygm-cli/internal/skills"
@@ -21,18 +22,40 @@ work with multiple organizations/clients.
 The .ygm.yml file can be committed to version control (it contains
 no secrets, just the org identifier).
 
+.ygm.yml files are layered: every .ygm.yml from the current directory up to
+the project root is merged, with the closest file winning. The project root
+is the git root, or the nearest directory whose .ygm.yml sets "root: true".
+By default link updates the nearest .ygm.yml; use --here or --root to choose.
+
 Precedence for organization selection:
   1. --org flag (highest)
-  2. .ygm.yml (project-specific)
-  3. default_org in ~/.config/ygm/config.yml`,
+  2. YGM_ORG environment variable
+  3. .ygm.yml (project-specific)
+  4. default_org in ~/.config/ygm/config.yml`,
 	Example: `  # Link to an org interactively (shows available orgs)
   ygm link
 
   # Link to a specific org
-  ygm link acme-corp`,
+  ygm link acme-corp
+
+  # In a monorepo: set the org once at the repository root...
+  ygm link acme-corp --root
+
+  # ...and give one package its own .ygm.yml that inherits from it
+  cd packages/docs && ygm link acme-corp --here`,
 	RunE: runLink,
 }
 
+var (
+	linkHereFlag bool
+	linkRootFlag bool
+)
+
+func init() {
+	linkCmd.Flags().BoolVar(&linkHereFlag, "here", false, "Write .ygm.yml in the current directory")
+	linkCmd.Flags().BoolVar(&linkRootFlag, "root", false, "Write .ygm.yml at the project root (git root or root: true)")
+}
+
 func runLink(cmd *cobra.Command, args []string) error {
 	// Load global config to get available accounts
 	globalCfg, err := config.Load()
@@ -56,7 +79,7 @@ func runLink(cmd *cobra.Command, args []string) error {
 			fmt.Fprintf(os.Stderr, "Organization '%s' not found.\n\n", orgSlug)
 			fmt.Fprintln(os.Stderr, "Available organizations:")
 			for slug, account := range globalCfg.Accounts {
-				fmt.Fprintf(os.Stderr, "  - %s (%s)\n", slug, account.OrgName)
+				fmt.Fprintf(os.Stderr, "  - %s (%s) %s\n", slug, account.OrgName, globalCfg.AccountAPIURL(account))
 			}
 			os.Exit(1)
 		}
@@ -73,7 +96,7 @@ func runLink(cmd *cobra.Command, args []string) error {
 			i := 1
 			slugs := make([]string, 0, len(globalCfg.Accounts))
 			for slug, account := range globalCfg.Accounts {
-				fmt.Printf("  %d. %s (%s)\n", i, slug, account.OrgName)
+				fmt.Printf("  %d. %s (%s) %s\n", i, slug, account.OrgName, globalCfg.AccountAPIURL(account))
 				slugs = append(slugs, slug)
 				i++
 			}
@@ -95,36 +118,102 @@ func runLink(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Check if already linked
-	existingPath, _ := config.LocalConfigPath()
-	if existingPath != "" {
-		existingCfg, _ := config.LoadLocal()
-		if existingCfg != nil && existingCfg.Org == orgSlug {
-			fmt.Printf("Already linked to '%s'\n", orgSlug)
-			return nil
-		}
-		if existingCfg != nil {
-			fmt.Printf("Updating link from '%s' to '%s'\n", existingCfg.Org, orgSlug)
-		}
+	target, err := linkTarget()
+	if err != nil {
+		return err
 	}
 
-	// Create local config
-	localCfg := &config.LocalConfig{
-		Org: orgSlug,
+	// Leave the file untouched if it's already linked
+	if existing, err := config.LoadLocalFile(target); err == nil && existing.Org == orgSlug {
+		if !jsonOutput {
+			fmt.Printf("Already linked to '%s' in %s\n", orgSlug, target)
+		}
+		return outputLinkResult(target, orgSlug)
 	}
 
-	if err := localCfg.Save(); err != nil {
+	// Only the target file is modified, under its lock; other settings in it
+	// are preserved
+	previous := ""
+	_, err = config.UpdateLocalFile(target, func(layer *config.LocalConfig) error {
+		previous = layer.Org
+		layer.Org = orgSlug
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed to save local config: %w", err)
 	}
 
-	account := globalCfg.Accounts[orgSlug]
-	fmt.Printf("Linked to '%s' (%s)\n", orgSlug, account.OrgName)
-	fmt.Printf("Created %s\n", config.LocalConfigFile)
+	if previous != "" && previous != orgSlug && !jsonOutput {
+		fmt.Printf("Updating link from '%s' to '%s'\n", previous, orgSlug)
+	}
+
+	if !jsonOutput {
+		account := globalCfg.Accounts[orgSlug]
+		fmt.Printf("Linked to '%s' (%s) %s\n", orgSlug, account.OrgName, globalCfg.AccountAPIURL(account))
+		fmt.Printf("Wrote %s\n", target)
+	}
 
 	// Install local agent skills for AI assistant discovery
-	if err := skills.InstallLocal(); err != nil {
+	if err := skills.InstallAt(filepath.Dir(target)); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: could not install local agent skills: %v\n", err)
 	}
 
-	return nil
+	return outputLinkResult(target, orgSlug)
+}
+
+// linkTarget returns the .ygm.yml that link should write: the current
+// directory with --here, the project root with --root, and otherwise the
+// nearest existing .ygm.yml (or the current directory if there is none)
+func linkTarget() (string, error) {
+	if linkHereFlag && linkRootFlag {
+		return "", fmt.Errorf("--here and --root are mutually exclusive")
+	}
+
+	var dir string
+	switch {
+	case linkRootFlag:
+		root, err := config.LocalRoot()
+		if err != nil {
+			return "", err
+		}
+		dir = root
+	case !linkHereFlag:
+		nearest, err := config.LocalConfigPath()
+		if err != nil {
+			return "", err
+		}
+		if nearest != "" {
+			return nearest, nil
+		}
+	}
+
+	if dir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		dir = cwd
+	}
+	return filepath.Join(dir, config.LocalConfigFile), nil
+}
+
+// outputLinkResult prints the resolved local config, with the file each
+// value came from, when --json is given
+func outputLinkResult(target, orgSlug string) error {
+	if !jsonOutput {
+		return nil
+	}
+
+	resolved, err := config.LoadLocal()
+	if err != nil {
+		return err
+	}
+	localCfg = resolved
+
+	return outputJSON(map[string]interface{}{
+		"org":      orgSlug,
+		"written":  target,
+		"files":    localCfg.Files(),
+		"settings": collectSettings(config.ScopeLocal),
+	})
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/api"
@@ -13,8 +14,9 @@ import (
 )
 
 var (
-	apiURLFlag   string
-	tokenNameFlag string
+	apiURLFlag     string
+	tokenNameFlag  string
+	loginForceFlag bool
 )
 
 var loginCmd = &cobra.Command{
@@ -23,13 +25,20 @@ var loginCmd = &cobra.Command{
 	Long: `Start the device flow authentication to connect the CLI to your account.
 
 This will open your browser where you can enter a code and authorize the CLI.
-Once authorized, the token will be saved locally for future use.`,
+Once authorized, the token will be saved locally for future use.
+
+Each account remembers the API URL it was created with, so staging and
+production organizations can be used side by side. Logging into an existing
+organization slug against a different API URL is refused unless --force is
+given. When the organization is known up front, from --org or YGM_ORG, this
+is checked before the browser opens.`,
 	RunE: runLogin,
 }
 
 func init() {
-	loginCmd.Flags().StringVar(&apiURLFlag, "api-url", config.DefaultAPIURL, "API URL (for development)")
+	loginCmd.Flags().StringVar(&apiURLFlag, "api-url", "", "API URL for this account (default: global api_url)")
 	loginCmd.Flags().StringVar(&tokenNameFlag, "name", "", "Name for this token (e.g., 'MacBook CLI')")
+	loginCmd.Flags().BoolVar(&loginForceFlag, "force", false, "Replace an existing account that uses a different API URL")
 }
 
 func runLogin(cmd *cobra.Command, args []string) error {
@@ -42,16 +51,25 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		cfg = config.NewConfig()
 	}
 
-	// Override API URL if specified
+	// The API URL applies to this account only; other accounts keep their own
+	apiURL := cfg.GlobalAPIURL()
 	if apiURLFlag != "" {
-		cfg.APIURL = apiURLFlag
+		apiURL = apiURLFlag
+	}
+
+	// Catch a known conflict before a token is authorized only to be
+	// thrown away; the check after the flow covers every other login
+	if !loginForceFlag {
+		if err := checkLoginConflict(cfg, apiURL); err != nil {
+			return err
+		}
 	}
 
 	fmt.Println("Starting device flow authentication...")
 	fmt.Println()
 
 	// Request device code
-	deviceFlow := auth.NewDeviceFlow(cfg.APIURL)
+	deviceFlow := auth.NewDeviceFlow(apiURL)
 	deviceCode, err := deviceFlow.RequestDeviceCode()
 	if err != nil {
 		return fmt.Errorf("failed to start authentication: %w", err)
@@ -107,22 +125,40 @@ func runLogin(cmd *cobra.Command, args []string) error {
 
 		token := result.token
 
-		// Save to config
-		cfg.AddAccount(token.Organization.Slug, config.Account{
-			Token:     token.AccessToken,
-			UserEmail: token.User.Email,
-			OrgID:     token.Organization.ID,
-			OrgName:   token.Organization.Name,
-		})
+		// Save to config, re-reading it under the lock so accounts added by
+		// concurrent logins aren't lost
+		_, err := config.Update(func(c *config.Config) error {
+			// Refuse to silently repoint an existing account at another environment
+			if existing, ok := c.Accounts[token.Organization.Slug]; ok && !loginForceFlag {
+				existingURL := c.AccountAPIURL(existing)
+				if existingURL != apiURL {
+					return fmt.Errorf("organization '%s' is already configured for %s (this login used %s); re-run with --force to replace it",
+						token.Organization.Slug, existingURL, apiURL)
+				}
+			}
 
-		if err := cfg.Save(); err != nil {
+			c.AddAccount(token.Organization.Slug, config.Account{
+				Token:     token.AccessToken,
+				UserEmail: token.User.Email,
+				OrgID:     token.Organization.ID,
+				OrgName:   token.Organization.Name,
+				APIURL:    apiURL,
+			})
+			return nil
+		})
+		if err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
 
-		// Auto-link current directory to this org
-		localCfg := &config.LocalConfig{Org: token.Organization.Slug}
+		// Auto-link current directory to this org, keeping any other
+		// settings already in its .ygm.yml
 		linkedDir, _ := os.Getwd()
-		if err := localCfg.Save(); err != nil {
+		localPath := filepath.Join(linkedDir, config.LocalConfigFile)
+		_, err = config.UpdateLocalFile(localPath, func(local *config.LocalConfig) error {
+			local.Org = token.Organization.Slug
+			return nil
+		})
+		if err != nil {
 			linkedDir = "" // Don't show linked dir if save failed
 		}
 
@@ -142,6 +178,7 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		fmt.Println()
 		fmt.Printf("Authenticated as: %s (%s)\n", token.User.Email, token.Organization.Name)
 		fmt.Printf("Organization slug: %s\n", token.Organization.Slug)
+		fmt.Printf("API URL: %s\n", apiURL)
 		if linkedDir != "" {
 			fmt.Printf("Linked directory: %s\n", linkedDir)
 		}
@@ -167,3 +204,21 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("authentication timed out - please try again")
 	}
 }
+
+// checkLoginConflict refuses a login for an organization chosen with --org
+// or YGM_ORG that is already configured for another API URL
+func checkLoginConflict(cfg *config.Config, apiURL string) error {
+	slug := orgFlag
+	if slug == "" {
+		slug = os.Getenv(config.EnvOrg)
+	}
+	existing, ok := cfg.Accounts[slug]
+	if slug == "" || !ok {
+		return nil
+	}
+	if existingURL := cfg.AccountAPIURL(existing); existingURL != apiURL {
+		return fmt.Errorf("organization '%s' is already configured for %s (this login would use %s); re-run with --force to replace it",
+			slug, existingURL, apiURL)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestLoginConflictBeforeDeviceFlow checks a login for an organization that
+// is already configured for another API URL is refused before any device
+// code is requested
+func TestLoginConflictBeforeDeviceFlow(t *testing.T) {
+	requests := 0
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "unexpected request", http.StatusTeapot)
+	}))
+	defer other.Close()
+
+	tests := []struct {
+		name string
+		args []string
+		env  string
+	}{
+		{name: "--org", args: []string{"--org", "acme"}},
+		{name: "YGM_ORG", env: "acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTestAccount(t, "https://ygm.example.test")
+			t.Setenv("YGM_ORG", tt.env)
+			requests = 0
+
+			args := append([]string{"login", "--api-url", other.URL}, tt.args...)
+			err := executeCommand(t, args...)
+			if err == nil || !strings.Contains(err.Error(), "already configured for https://ygm.example.test") {
+				t.Fatalf("error = %v, want a conflict", err)
+			}
+			if requests != 0 {
+				t.Errorf("%d requests made before refusing, want none", requests)
+			}
+		})
+	}
+}
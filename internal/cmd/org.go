@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var orgCmd = &cobra.Command{
+	Use:   "org",
+	Short: "Manage the organizations you're logged into",
+	Long: `List, switch between and refresh the organizations you're logged into.
+
+Subcommands:
+  list      List organizations
+  use       Set the default organization
+  refresh   Re-fetch organization and user details from the API`,
+}
+
+var orgListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List organizations you're logged into",
+	Long: `List every organization in ~/.config/ygm/config.yml.
+
+The default organization is marked with *, and the one this directory is
+linked to (via .ygm.yml) is marked as linked.`,
+	Args: cobra.NoArgs,
+	RunE: runOrgList,
+}
+
+func init() {
+	orgCmd.AddCommand(orgListCmd)
+	orgCmd.AddCommand(orgUseCmd)
+	orgCmd.AddCommand(orgRefreshCmd)
+}
+
+// orgInfo is an account as shown by the org commands
+type orgInfo struct {
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	OrgID     int    `json:"org_id"`
+	UserEmail string `json:"user_email"`
+	APIURL    string `json:"api_url"`
+	Default   bool   `json:"default"`
+	Linked    bool   `json:"linked"`
+}
+
+// listOrgs returns all configured accounts, sorted by slug
+func listOrgs() []orgInfo {
+	orgs := make([]orgInfo, 0, len(cfg.Accounts))
+	for _, slug := range cfg.Slugs() {
+		account := cfg.Accounts[slug]
+		orgs = append(orgs, orgInfo{
+			Slug:      slug,
+			Name:      account.OrgName,
+			OrgID:     account.OrgID,
+			UserEmail: account.UserEmail,
+			APIURL:    cfg.AccountAPIURL(account),
+			Default:   slug == cfg.DefaultOrg,
+			Linked:    localCfg != nil && localCfg.Org == slug,
+		})
+	}
+	return orgs
+}
+
+func runOrgList(cmd *cobra.Command, args []string) error {
+	orgs := listOrgs()
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"organizations": orgs})
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SLUG\tNAME\tEMAIL\tAPI URL\tLINKED")
+	for _, org := range orgs {
+		marker := " "
+		if org.Default {
+			marker = "*"
+		}
+		linked := ""
+		if org.Linked {
+			linked = "linked"
+		}
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t%s\t%s\n", marker, org.Slug, org.Name, org.UserEmail, org.APIURL, linked)
+	}
+	return w.Flush()
+}
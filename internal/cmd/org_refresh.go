@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var orgRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Re-fetch organization and user details",
+	Long: `Re-fetch the organization name, organization ID and user email for every
+account from the API and save them to ~/.config/ygm/config.yml.
+
+Use it after an organization has been renamed in the web app.`,
+	Args: cobra.NoArgs,
+	RunE: runOrgRefresh,
+}
+
+// orgRefreshResult is the outcome of refreshing one account
+type orgRefreshResult struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name,omitempty"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+func runOrgRefresh(cmd *cobra.Command, args []string) error {
+	fetched := make(map[string]*api.CurrentUserResponse)
+	var results []orgRefreshResult
+	failed := 0
+
+	for _, slug := range cfg.Slugs() {
+		account := cfg.Accounts[slug]
+		apiURL, _ := resolveAPIURL(account)
+		client := api.NewClient(apiURL, account.Token)
+
+		me, err := client.GetCurrentUser()
+		if err != nil {
+			results = append(results, orgRefreshResult{Slug: slug, Error: err.Error()})
+			failed++
+			continue
+		}
+		fetched[slug] = me
+	}
+
+	// Apply under the lock so accounts added by a concurrent login survive
+	_, err := config.Update(func(c *config.Config) error {
+		for slug, me := range fetched {
+			account, ok := c.Accounts[slug]
+			if !ok {
+				continue // Logged out meanwhile
+			}
+			changed := account.OrgName != me.Organization.Name ||
+				account.OrgID != me.Organization.ID ||
+				account.UserEmail != me.User.Email
+			account.OrgName = me.Organization.Name
+			account.OrgID = me.Organization.ID
+			account.UserEmail = me.User.Email
+			c.Accounts[slug] = account
+			results = append(results, orgRefreshResult{Slug: slug, Name: account.OrgName, Changed: changed})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Slug < results[j].Slug })
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"organizations": results}); err != nil {
+			return err
+		}
+	} else {
+		for _, r := range results {
+			switch {
+			case r.Error != "":
+				fmt.Fprintf(os.Stderr, "  %s: %s\n", r.Slug, r.Error)
+			case r.Changed:
+				fmt.Printf("  %s: updated (%s)\n", r.Slug, r.Name)
+			default:
+				fmt.Printf("  %s: up to date (%s)\n", r.Slug, r.Name)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to refresh %d of %d organizations", failed, len(cfg.Accounts))
+	}
+	return nil
+}
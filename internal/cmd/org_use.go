@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var orgUseCmd = &cobra.Command{
+	Use:   "use <slug>",
+	Short: "Set the default organization",
+	Long: `Set default_org in ~/.config/ygm/config.yml.
+
+The default is used in directories that aren't linked to an organization
+with .ygm.yml and when --org isn't given.
+
+Examples:
+  ygm org use acme-corp`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOrgUse,
+}
+
+func runOrgUse(cmd *cobra.Command, args []string) error {
+	slug := args[0]
+
+	updated, err := config.Update(func(c *config.Config) error {
+		return c.Set("default_org", slug)
+	})
+	if err != nil {
+		return err
+	}
+	cfg = updated
+
+	if jsonOutput {
+		return outputJSON(map[string]string{"default_org": slug})
+	}
+
+	account := cfg.Accounts[slug]
+	fmt.Printf("Default organization is now '%s' (%s)\n", slug, account.OrgName)
+	if localCfg != nil && localCfg.Org != "" && localCfg.Org != slug {
+		fmt.Printf("Note: this directory is linked to '%s', which takes precedence here\n", localCfg.Org)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var releaseCmd = &cobra.Command{
+	Use:   "release",
+	Short: "Turn releases of this repository into marketing tasks",
+	Long: `Work with releases of the git repository you're in.
+
+Everything is read from local git; no GitHub access is needed.
+
+Subcommands:
+  announce  Draft launch tasks for a tagged release`,
+}
+
+func init() {
+	releaseCmd.AddCommand(releaseAnnounceCmd)
+}
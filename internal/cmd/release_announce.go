@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/release"
+	"github.com/spf13/cobra"
+)
+
+var releaseAnnounceCmd = &cobra.Command{
+	Use:   "announce [tag]",
+	Short: "Draft launch tasks for a tagged release",
+	Long: `Propose launch tasks announcing a release, one per platform, and create
+them once you confirm.
+
+The release is read from local git: the tag (the latest one if not given),
+the commits since the previous tag and the tag's section of CHANGELOG.md.
+Conventional Commits (feat:, fix:, perf:, ...) are grouped, and chores,
+docs, tests and refactors are left out. The changelog entry, if there is
+one, is preferred over commit messages.
+
+Each task gets a drafted description and a post date: the blog and Twitter
+on --date (default today), LinkedIn a day later, Reddit two days and email
+three days after. Tasks whose title already exists in the plan are skipped,
+so announcing the same release twice is safe.
+
+Examples:
+  ygm release announce
+  ygm release announce v1.2.0 --dry-run
+  ygm release announce --platforms blog,twitter,linkedin,reddit --date 2026-11-03
+  ygm release announce v1.2.0 --yes --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReleaseAnnounce,
+}
+
+var (
+	announcePlatforms []string
+	announceDate      string
+	announceChangelog string
+	announceName      string
+	announceDryRun    bool
+	announceYes       bool
+)
+
+func init() {
+	releaseAnnounceCmd.Flags().StringSliceVar(&announcePlatforms, "platforms", release.DefaultPlatforms, "Platforms to announce on")
+	releaseAnnounceCmd.Flags().StringVar(&announceDate, "date", "", "First post date (YYYY-MM-DD, default today)")
+	releaseAnnounceCmd.Flags().StringVar(&announceChangelog, "changelog", "", "Changelog file (default CHANGELOG.md in the repository root)")
+	releaseAnnounceCmd.Flags().StringVar(&announceName, "name", "", "Product name used in the drafts (default the repository name)")
+	releaseAnnounceCmd.Flags().BoolVar(&announceDryRun, "dry-run", false, "Show the proposed tasks without creating them")
+	releaseAnnounceCmd.Flags().BoolVarP(&announceYes, "yes", "y", false, "Create the tasks without asking")
+}
+
+func runReleaseAnnounce(cmd *cobra.Command, args []string) error {
+	tag := ""
+	if len(args) == 1 {
+		tag = args[0]
+	}
+
+	start := time.Now()
+	if announceDate != "" {
+		var err error
+		if start, err = time.ParseInLocation("2006-01-02", announceDate, time.Local); err != nil {
+			return fmt.Errorf("invalid --date %q (expected YYYY-MM-DD)", announceDate)
+		}
+	}
+
+	rel, err := release.Read(".", tag)
+	if err != nil {
+		return err
+	}
+	if err := rel.ReadChangelog(announceChangelog); err != nil {
+		return err
+	}
+	if announceName != "" {
+		rel.Project = announceName
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	// Skip announcements that were already created
+	existing, err := client.GetTasks(api.TaskFilter{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing tasks: %w", err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		seen[duplicateKey(t.Title, nil)] = true
+	}
+
+	var drafts, skipped []api.CreateTaskRequest
+	for _, d := range release.Drafts(rel, announcePlatforms, start) {
+		if seen[duplicateKey(d.Title, nil)] {
+			skipped = append(skipped, d)
+		} else {
+			drafts = append(drafts, d)
+		}
+	}
+
+	if !jsonOutput {
+		outputAnnouncement(rel, drafts, skipped)
+	}
+
+	if announceDryRun || len(drafts) == 0 {
+		if jsonOutput {
+			return outputJSON(map[string]interface{}{
+				"release": releaseSummary(rel),
+				"dry_run": announceDryRun,
+				"tasks":   drafts,
+				"skipped": skipped,
+			})
+		}
+		return nil
+	}
+
+	if !announceYes {
+		ok, err := confirm(fmt.Sprintf("Create %d %s?", len(drafts), plural(len(drafts), "task", "tasks")))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Nothing created.")
+			return nil
+		}
+	}
+
+	created := []api.Task{}
+	for _, d := range drafts {
+		task, err := client.CreateTask(d)
+		if err != nil {
+			return fmt.Errorf("failed to create %q (%d created before it): %w", d.Title, len(created), err)
+		}
+		created = append(created, *task)
+		if !jsonOutput {
+			fmt.Printf("Created task #%d: %s\n", task.ID, task.Title)
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"release": releaseSummary(rel),
+			"created": created,
+			"skipped": skipped,
+		})
+	}
+	return nil
+}
+
+// releaseSummary describes the release in JSON output
+func releaseSummary(rel *release.Release) map[string]interface{} {
+	return map[string]interface{}{
+		"project":      rel.Project,
+		"tag":          rel.Tag,
+		"previous_tag": rel.PreviousTag,
+		"date":         rel.Date,
+		"notes":        rel.Notes(),
+		"changelog":    rel.Changelog,
+	}
+}
+
+func outputAnnouncement(rel *release.Release, drafts, skipped []api.CreateTaskRequest) {
+	fmt.Printf("Release %s %s (%s)\n", rel.Project, rel.Tag, rel.Date.Local().Format("2006-01-02"))
+	since := "in this first release"
+	if rel.PreviousTag != "" {
+		since = "since " + rel.PreviousTag
+	}
+	source := "no changelog entry; drafted from commits"
+	if rel.Changelog != "" {
+		source = "using its changelog entry"
+	}
+	fmt.Printf("%d %s %s, %s\n", len(rel.Commits), plural(len(rel.Commits), "commit", "commits"), since, source)
+	fmt.Println()
+
+	for _, d := range drafts {
+		fmt.Printf("[%s] %s  %s\n", d.Platform, *d.SuggestedPostDate, d.Title)
+		for _, line := range strings.Split(d.Description, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+		fmt.Println()
+	}
+	for _, d := range skipped {
+		fmt.Printf("Skipping %q: a task with that title already exists\n", d.Title)
+	}
+	if len(drafts) == 0 {
+		fmt.Println("Nothing to create.")
+	}
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
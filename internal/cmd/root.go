@@ -42,14 +42,26 @@ for use with AI coding assistants.`,
 			return fmt.Errorf("failed to load config: %w", err)
 		}
 
+		// Load local config (optional, won't fail if not present). An invalid
+		// one is fatal, except for the commands used to fix or remove it.
+		localCfg, err = config.LoadLocal()
+		if err != nil {
+			if !isSubcommandOf(cmd, "config") && cmd.Name() != "unlink" {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+
+		// Config and hooks commands work without being logged in
+		if isSubcommandOf(cmd, "config") || isSubcommandOf(cmd, "hooks") {
+			return nil
+		}
+
 		if cfg == nil || len(cfg.Accounts) == 0 {
 			fmt.Fprintln(os.Stderr, "Not logged in. Run 'ygm login' first.")
 			os.Exit(1)
 		}
 
-		// Load local config (optional, won't fail if not present)
-		localCfg, _ = config.LoadLocal()
-
 		return nil
 	},
 }
@@ -66,9 +78,24 @@ func init() {
 	rootCmd.AddCommand(loginCmd)
 	rootCmd.AddCommand(brandCmd)
 	rootCmd.AddCommand(tasksCmd)
+	rootCmd.AddCommand(releaseCmd)
+	rootCmd.AddCommand(hooksCmd)
 	rootCmd.AddCommand(contextCmd)
 	rootCmd.AddCommand(linkCmd)
 	rootCmd.AddCommand(unlinkCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(orgCmd)
+}
+
+// isSubcommandOf reports whether cmd is the top-level command name or one
+// of its subcommands
+func isSubcommandOf(cmd *cobra.Command, name string) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Name() == name && c.Parent() == c.Root() {
+			return true
+		}
+	}
+	return false
 }
 
 var versionCmd = &cobra.Command{
@@ -81,28 +108,17 @@ var versionCmd = &cobra.Command{
 
 // getActiveAccount returns the account to use based on precedence:
 // 1. --org flag (highest priority)
-// 2. .ygm.yml local config (project-specific)
-// 3. default_org in global config
-// 4. First available account (fallback)
+// 2. YGM_ORG environment variable
+// 3. .ygm.yml local config (project-specific)
+// 4. default_org in global config
+// 5. First available account (fallback)
 func getActiveAccount() (*config.Account, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("not logged in")
 	}
 
-	// Precedence: --org flag > local .ygm.yml > global default_org
-	orgSlug := orgFlag
-	if orgSlug == "" && localCfg != nil && localCfg.Org != "" {
-		orgSlug = localCfg.Org
-	}
-	if orgSlug == "" {
-		orgSlug = cfg.DefaultOrg
-	}
-
+	orgSlug, _ := resolveOrg()
 	if orgSlug == "" {
-		// Return first account if no default set
-		for _, account := range cfg.Accounts {
-			return &account, nil
-		}
 		return nil, fmt.Errorf("no accounts configured")
 	}
 
@@ -110,6 +126,56 @@ func getActiveAccount() (*config.Account, error) {
 	if !ok {
 		return nil, fmt.Errorf("organization '%s' not found in config. Run 'ygm login' to add it.", orgSlug)
 	}
+	account.APIURL, _ = resolveAPIURL(account)
 
 	return &account, nil
 }
+
+// Sources a setting's effective value can come from
+const (
+	sourceFlag    = "flag"
+	sourceEnv     = "env"
+	sourceLocal   = "local"
+	sourceGlobal  = "global"
+	sourceAccount = "account" // The account's own entry in the global config
+	sourceDefault = "default"
+)
+
+// resolveOrg returns the organization slug to use and where it came from
+func resolveOrg() (string, string) {
+	if orgFlag != "" {
+		return orgFlag, sourceFlag
+	}
+	if env := os.Getenv(config.EnvOrg); env != "" {
+		return env, sourceEnv
+	}
+	if localCfg != nil && localCfg.Org != "" {
+		return localCfg.Org, sourceLocal
+	}
+	if cfg == nil {
+		return "", ""
+	}
+	if cfg.DefaultOrg != "" {
+		return cfg.DefaultOrg, sourceGlobal
+	}
+
+	// Fall back to the first account if no default is set
+	if slugs := cfg.Slugs(); len(slugs) > 0 {
+		return slugs[0], sourceDefault
+	}
+	return "", ""
+}
+
+// resolveAPIURL returns the API URL to use for an account and where it came from
+func resolveAPIURL(account config.Account) (string, string) {
+	if env := os.Getenv(config.EnvAPIURL); env != "" {
+		return env, sourceEnv
+	}
+	if account.APIURL != "" {
+		return account.APIURL, sourceAccount
+	}
+	if cfg != nil && cfg.APIURL != "" {
+		return cfg.APIURL, sourceGlobal
+	}
+	return config.DefaultAPIURL, sourceDefault
+}
@@ -2,14 +2,18 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
 var (
-	statusFilter   string
-	platformFilter string
+	statusFilter    string
+	platformFilter  string
+	discardedFilter bool
 )
 
 var tasksCmd = &cobra.Command{
@@ -20,21 +24,58 @@ var tasksCmd = &cobra.Command{
 Tasks include content creation items like social media posts,
 blog articles, and other marketing materials.
 
-When run without a subcommand, lists all tasks.
+When run without a subcommand, lists all tasks. Default --status and
+--platform filters can be set per project in .ygm.yml under tasks.filters;
+the default status filter doesn't apply to --discarded.
 
 Subcommands:
   create    Create a new marketing task
-  update    Update a task's title, description, or status
-  discard   Soft-delete a task`,
+  import    Create tasks from a CSV, JSON or YAML file
+  export    Export tasks as CSV, TSV, Markdown or HTML
+  ical      Export scheduled tasks as an iCalendar feed
+  calendar  Show scheduled tasks in a month or week calendar
+  board     Interactive kanban board
+  update    Update a task's fields
+  complete  Mark tasks as completed once they're ready
+  edit      Edit a task in $EDITOR as Markdown
+  discard   Soft-delete a task
+  move      Change a task's position
+  prompt    Print a task's image, copy or video prompt
+  generate  Generate copy for a task with a local tool
+  copy      Show, upload or select a task's copy
+  images    List, download or upload a task's images
+  suggestions  Review tasks suggested by the git hooks
+  reorder   Set the order of tasks from a list of IDs
+  restore   Restore discarded tasks
+  purge     Permanently delete discarded tasks
+
+Use --discarded to list soft-deleted tasks instead of active ones.`,
 	RunE: runTasks,
 }
 
 func init() {
 	tasksCmd.Flags().StringVar(&statusFilter, "status", "", "Filter by status (pending, in_progress, completed)")
 	tasksCmd.Flags().StringVar(&platformFilter, "platform", "", "Filter by platform (instagram, twitter, linkedin, etc.)")
+	tasksCmd.Flags().BoolVar(&discardedFilter, "discarded", false, "List discarded tasks instead of active ones")
 	tasksCmd.AddCommand(tasksCreateCmd)
+	tasksCmd.AddCommand(tasksImportCmd)
+	tasksCmd.AddCommand(tasksExportCmd)
+	tasksCmd.AddCommand(tasksICalCmd)
+	tasksCmd.AddCommand(tasksCalendarCmd)
+	tasksCmd.AddCommand(tasksBoardCmd)
 	tasksCmd.AddCommand(tasksUpdateCmd)
+	tasksCmd.AddCommand(tasksCompleteCmd)
+	tasksCmd.AddCommand(tasksEditCmd)
 	tasksCmd.AddCommand(tasksDiscardCmd)
+	tasksCmd.AddCommand(tasksMoveCmd)
+	tasksCmd.AddCommand(tasksPromptCmd)
+	tasksCmd.AddCommand(tasksGenerateCmd)
+	tasksCmd.AddCommand(tasksCopyCmd)
+	tasksCmd.AddCommand(tasksImagesCmd)
+	tasksCmd.AddCommand(tasksSuggestionsCmd)
+	tasksCmd.AddCommand(tasksReorderCmd)
+	tasksCmd.AddCommand(tasksRestoreCmd)
+	tasksCmd.AddCommand(tasksPurgeCmd)
 }
 
 func runTasks(cmd *cobra.Command, args []string) error {
@@ -43,12 +84,32 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
-	tasks, err := client.GetTasks(statusFilter, platformFilter)
+	// Flags take precedence over the project's default filters in .ygm.yml.
+	// Discarded tasks keep the status they had, so a default status filter
+	// would hide most of them.
+	status, platform := statusFilter, platformFilter
+	if localCfg != nil {
+		if !cmd.Flags().Changed("status") && !discardedFilter {
+			status = localCfg.Tasks.Filters.Status
+		}
+		if !cmd.Flags().Changed("platform") {
+			platform = localCfg.Tasks.Filters.Platform
+		}
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	tasks, err := client.GetTasks(api.TaskFilter{Status: status, Platform: platform, Discarded: discardedFilter})
 	if err != nil {
 		return fmt.Errorf("failed to fetch tasks: %w", err)
 	}
 
+	// Remember what we read so later updates can detect concurrent changes
+	if !discardedFilter {
+		cache := loadTaskCache()
+		cache.Remember(tasks...)
+		saveTaskCache(cache)
+	}
+
 	if len(tasks) == 0 {
 		fmt.Println("No tasks found.")
 		return nil
@@ -58,9 +119,83 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		return outputJSON(map[string]interface{}{"tasks": tasks})
 	}
 
+	if discardedFilter {
+		return outputDiscardedTasksText(tasks)
+	}
+
 	return outputTasksText(tasks)
 }
 
+func outputDiscardedTasksText(tasks []api.Task) error {
+	fmt.Printf("Discarded Tasks (%d total)\n", len(tasks))
+	fmt.Println("==========================")
+	fmt.Println()
+
+	for _, t := range tasks {
+		printTask(t)
+	}
+	fmt.Println()
+	fmt.Println("Restore with 'ygm tasks restore <id>' or delete permanently with 'ygm tasks purge <id>'.")
+
+	return nil
+}
+
+// maxTaskRange limits how many IDs a single range argument may expand to,
+// to catch typos like 10-10000
+const maxTaskRange = 1000
+
+// parseTaskIDs parses task ID arguments. Each argument is an ID or an
+// inclusive range like 10-15. Duplicates are dropped, keeping the order.
+func parseTaskIDs(args []string) ([]int, error) {
+	ids := make([]int, 0, len(args))
+	seen := make(map[int]bool)
+	add := func(id int) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, arg := range args {
+		from, to, isRange := strings.Cut(arg, "-")
+		if !isRange {
+			id, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid task ID: %s", arg)
+			}
+			add(id)
+			continue
+		}
+
+		start, err1 := strconv.Atoi(from)
+		end, err2 := strconv.Atoi(to)
+		if err1 != nil || err2 != nil || start > end {
+			return nil, fmt.Errorf("invalid task ID range: %s (expected e.g. 10-15)", arg)
+		}
+		if end-start >= maxTaskRange {
+			return nil, fmt.Errorf("task ID range %s is too large (at most %d tasks)", arg, maxTaskRange)
+		}
+		for id := start; id <= end; id++ {
+			add(id)
+		}
+	}
+	return ids, nil
+}
+
+// taskFailure records a task that could not be processed in a multi-task command
+type taskFailure struct {
+	ID    int    `json:"id"`
+	Error string `json:"error"`
+}
+
+// failedTasksError summarizes failures after they were reported individually
+func failedTasksError(failed []taskFailure, total int) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d tasks failed", len(failed), total)
+}
+
 func outputTasksText(tasks []api.Task) error {
 	fmt.Printf("Tasks (%d total)\n", len(tasks))
 	fmt.Println("================")
@@ -71,6 +206,7 @@ func outputTasksText(tasks []api.Task) error {
 	inProgress := []api.Task{}
 	completed := []api.Task{}
 
+	sortByPosition(tasks)
 	for _, t := range tasks {
 		switch t.Status {
 		case "pending":
@@ -109,6 +245,17 @@ func outputTasksText(tasks []api.Task) error {
 	return nil
 }
 
+// sortByPosition sorts tasks by their position, as set in the web app or
+// with 'ygm tasks move'
+func sortByPosition(tasks []api.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Position != tasks[j].Position {
+			return tasks[i].Position < tasks[j].Position
+		}
+		return tasks[i].ID < tasks[j].ID
+	})
+}
+
 func printTask(t api.Task) {
 	platform := t.Platform
 	if platform == "" {
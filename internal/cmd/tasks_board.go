@@ -0,0 +1,562 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var (
+	boardRefresh  time.Duration
+	boardPlatform string
+)
+
+var tasksBoardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Interactive kanban board of tasks",
+	Long: `Open a full-screen kanban board with a column per status: pending, in
+progress and done (completed or shared).
+
+Keys:
+  ←/→ h/l     Select column         ↑/↓ k/j   Select task
+  </> H/L     Move task to the previous/next column
+  Enter       Show or hide task details
+  d           Discard task (asks to confirm)
+  p           Cycle the platform filter
+  r           Refresh now
+  q, Ctrl+C   Quit
+
+Changes are shown immediately and saved in the background. If saving fails,
+the change is undone and the error is shown at the bottom of the screen. A
+task is only moved to done if it passes the checks of 'ygm tasks complete'.
+The board reloads every --refresh interval (0 to disable).
+
+Examples:
+  ygm tasks board
+  ygm tasks board --platform twitter --refresh 1m`,
+	Args: cobra.NoArgs,
+	RunE: runTasksBoard,
+}
+
+func init() {
+	tasksBoardCmd.Flags().DurationVar(&boardRefresh, "refresh", 30*time.Second, "How often to reload tasks (0 to disable)")
+	tasksBoardCmd.Flags().StringVar(&boardPlatform, "platform", "", "Only show tasks for this platform")
+}
+
+// boardColumn is one status column of the board
+type boardColumn struct {
+	Title    string
+	Statuses []string // Task statuses shown in the column
+	Target   string   // Status given to tasks moved into the column
+}
+
+var boardColumns = []boardColumn{
+	{Title: "Pending", Statuses: []string{"pending"}, Target: "pending"},
+	{Title: "In progress", Statuses: []string{"in_progress"}, Target: "in_progress"},
+	{Title: "Done", Statuses: []string{"completed", "shared"}, Target: "completed"},
+}
+
+// Events handled by the board's main loop
+type (
+	boardKey    string
+	boardLoaded struct {
+		tasks []api.Task
+		err   error
+	}
+	boardDetail struct {
+		id   int
+		task *api.Task
+		err  error
+	}
+	// boardWrite reports the result of a background change. prev is the
+	// task before the change, to undo it on failure.
+	boardWrite struct {
+		action string // "move" or "discard"
+		prev   api.Task
+		task   *api.Task
+		err    error
+	}
+)
+
+// board is the state of the kanban board
+type board struct {
+	client *api.Client
+	org    string
+	events chan interface{}
+
+	tasks       []api.Task
+	saving      map[int]bool
+	details     map[int]*api.Task
+	loading     bool
+	loaded      bool
+	lastRefresh time.Time
+
+	col      int
+	row      [3]int
+	offset   [3]int
+	platform string
+	detail   bool
+	confirm  int // ID of the task waiting for discard confirmation
+
+	message  string
+	errorMsg bool
+
+	width, height int
+}
+
+func runTasksBoard(cmd *cobra.Command, args []string) error {
+	if jsonOutput {
+		return fmt.Errorf("tasks board is interactive; use 'ygm tasks --json' for JSON output")
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return fmt.Errorf("tasks board needs an interactive terminal; try 'ygm tasks' or 'ygm tasks calendar'")
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	org, _ := resolveOrg()
+	b := &board{
+		client:   api.NewClient(account.APIURL, account.Token),
+		org:      org,
+		events:   make(chan interface{}, 64),
+		saving:   make(map[int]bool),
+		details:  make(map[int]*api.Task),
+		platform: boardPlatform,
+	}
+	return b.run()
+}
+
+// run takes over the terminal until the user quits
+func (b *board) run() error {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	// Alternate screen, hidden cursor
+	os.Stdout.WriteString("\x1b[?1049h\x1b[?25l")
+	defer os.Stdout.WriteString("\x1b[?25h\x1b[?1049l")
+
+	go b.readKeys()
+	b.refresh()
+
+	var refresh <-chan time.Time
+	if boardRefresh > 0 {
+		ticker := time.NewTicker(boardRefresh)
+		defer ticker.Stop()
+		refresh = ticker.C
+	}
+	// There is no portable resize signal, so poll the size
+	resize := time.NewTicker(250 * time.Millisecond)
+	defer resize.Stop()
+
+	b.updateSize()
+	dirty := true
+	for {
+		if dirty {
+			b.render()
+		}
+
+		select {
+		case ev := <-b.events:
+			if b.handle(ev) {
+				return nil
+			}
+			dirty = true
+		case <-refresh:
+			b.refresh()
+			dirty = true
+		case <-resize.C:
+			dirty = b.updateSize()
+		}
+	}
+}
+
+// updateSize reads the terminal size and reports whether it changed
+func (b *board) updateSize() bool {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || (width == b.width && height == b.height) {
+		return false
+	}
+	b.width, b.height = width, height
+	return true
+}
+
+// refresh reloads all tasks in the background
+func (b *board) refresh() {
+	if b.loading {
+		return
+	}
+	b.loading = true
+	go func() {
+		tasks, err := b.client.GetTasks(api.TaskFilter{})
+		b.events <- boardLoaded{tasks: tasks, err: err}
+	}()
+}
+
+// handle applies an event and reports whether to quit
+func (b *board) handle(ev interface{}) bool {
+	switch ev := ev.(type) {
+	case boardKey:
+		return b.handleKey(string(ev))
+
+	case boardLoaded:
+		b.loading = false
+		if ev.err != nil {
+			b.setError("Couldn't load tasks: %v", ev.err)
+			return false
+		}
+		b.merge(ev.tasks)
+		b.loaded = true
+		b.lastRefresh = time.Now()
+		b.details = make(map[int]*api.Task)
+		if b.detail {
+			b.loadDetail()
+		}
+
+	case boardDetail:
+		if ev.err != nil {
+			b.setError("Couldn't load task #%d: %v", ev.id, ev.err)
+			return false
+		}
+		b.details[ev.id] = ev.task
+
+	case boardWrite:
+		b.finishWrite(ev)
+	}
+	return false
+}
+
+// merge replaces the tasks with a fresh list, keeping local versions of
+// tasks that are still being saved
+func (b *board) merge(tasks []api.Task) {
+	local := make(map[int]api.Task)
+	for _, t := range b.tasks {
+		if b.saving[t.ID] {
+			local[t.ID] = t
+		}
+	}
+	merged := make([]api.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if l, ok := local[t.ID]; ok {
+			t = l
+		} else if b.saving[t.ID] {
+			continue // Being discarded
+		}
+		merged = append(merged, t)
+	}
+	b.tasks = merged
+	b.clampCursor()
+}
+
+func (b *board) handleKey(key string) bool {
+	if b.confirm != 0 {
+		id := b.confirm
+		b.confirm = 0
+		if key == "y" || key == "Y" {
+			b.discard(id)
+		} else {
+			b.setMessage("Discard cancelled")
+		}
+		return false
+	}
+
+	switch key {
+	case "q", "ctrl-c":
+		return true
+	case "esc":
+		b.detail = false
+	case "left", "h":
+		b.selectColumn(b.col - 1)
+	case "right", "l":
+		b.selectColumn(b.col + 1)
+	case "up", "k":
+		b.row[b.col]--
+		b.clampCursor()
+	case "down", "j":
+		b.row[b.col]++
+		b.clampCursor()
+	case "home", "g":
+		b.row[b.col] = 0
+		b.clampCursor()
+	case "end", "G":
+		b.row[b.col] = len(b.column(b.col)) - 1
+		b.clampCursor()
+	case "<", "H":
+		b.move(-1)
+	case ">", "L":
+		b.move(1)
+	case "enter", " ":
+		b.detail = !b.detail
+	case "d":
+		if t := b.selected(); t != nil {
+			b.confirm = t.ID
+			b.setMessage("Discard #%d %q? Press y to confirm, any other key to cancel", t.ID, t.Title)
+		}
+	case "p":
+		b.cyclePlatform()
+	case "r":
+		b.refresh()
+		b.setMessage("Refreshing…")
+	}
+
+	if b.detail {
+		b.loadDetail()
+	}
+	return false
+}
+
+// column returns the visible tasks of a column in board order
+func (b *board) column(i int) []api.Task {
+	var tasks []api.Task
+	for _, t := range b.tasks {
+		if slices.Contains(boardColumns[i].Statuses, t.Status) && (b.platform == "" || t.Platform == b.platform) {
+			tasks = append(tasks, t)
+		}
+	}
+	sortByPosition(tasks)
+	return tasks
+}
+
+// selected returns the task under the cursor, if any
+func (b *board) selected() *api.Task {
+	tasks := b.column(b.col)
+	if b.row[b.col] < 0 || b.row[b.col] >= len(tasks) {
+		return nil
+	}
+	return b.find(tasks[b.row[b.col]].ID)
+}
+
+// find returns the task with the given ID
+func (b *board) find(id int) *api.Task {
+	for i := range b.tasks {
+		if b.tasks[i].ID == id {
+			return &b.tasks[i]
+		}
+	}
+	return nil
+}
+
+func (b *board) selectColumn(col int) {
+	if col < 0 || col >= len(boardColumns) {
+		return
+	}
+	b.col = col
+	b.clampCursor()
+}
+
+// selectTask moves the cursor to a task, wherever it is
+func (b *board) selectTask(id int) {
+	for col := range boardColumns {
+		for row, t := range b.column(col) {
+			if t.ID == id {
+				b.col, b.row[col] = col, row
+				return
+			}
+		}
+	}
+}
+
+func (b *board) clampCursor() {
+	for col := range boardColumns {
+		n := len(b.column(col))
+		if b.row[col] >= n {
+			b.row[col] = n - 1
+		}
+		if b.row[col] < 0 {
+			b.row[col] = 0
+		}
+	}
+}
+
+func (b *board) cyclePlatform() {
+	seen := make(map[string]bool)
+	platforms := []string{""}
+	for _, t := range b.tasks {
+		if t.Platform != "" && !seen[t.Platform] {
+			seen[t.Platform] = true
+			platforms = append(platforms, t.Platform)
+		}
+	}
+	sort.Strings(platforms[1:])
+
+	next := 0
+	for i, p := range platforms {
+		if p == b.platform {
+			next = (i + 1) % len(platforms)
+		}
+	}
+	b.platform = platforms[next]
+	b.clampCursor()
+	if b.platform == "" {
+		b.setMessage("Showing all platforms")
+	} else {
+		b.setMessage("Showing %s only", b.platform)
+	}
+}
+
+// loadDetail fetches the selected task's details if they aren't loaded yet
+func (b *board) loadDetail() {
+	t := b.selected()
+	if t == nil {
+		return
+	}
+	if _, ok := b.details[t.ID]; ok {
+		return
+	}
+	b.details[t.ID] = nil // Loading
+	id := t.ID
+	go func() {
+		task, err := b.client.GetTask(id)
+		b.events <- boardDetail{id: id, task: task, err: err}
+	}()
+}
+
+// move moves the selected task to a neighbouring column. The card moves
+// immediately; the change is saved in the background.
+func (b *board) move(dir int) {
+	t := b.selected()
+	target := b.col + dir
+	if t == nil || target < 0 || target >= len(boardColumns) {
+		return
+	}
+	if b.saving[t.ID] {
+		b.setMessage("Still saving #%d…", t.ID)
+		return
+	}
+
+	prev := *t
+	status := boardColumns[target].Target
+	t.Status = status
+	b.saving[t.ID] = true
+	b.selectTask(t.ID)
+	b.setMessage("Moving #%d to %s…", t.ID, boardColumns[target].Title)
+
+	go func() {
+		if status == "completed" {
+			if err := b.checkReady(prev.ID); err != nil {
+				b.events <- boardWrite{action: "move", prev: prev, err: err}
+				return
+			}
+		}
+		req := api.UpdateTaskRequest{Status: api.Set(status)}
+		task, err := b.client.UpdateTask(prev.ID, req, api.PreconditionFor(&prev))
+		b.events <- boardWrite{action: "move", prev: prev, task: task, err: err}
+	}()
+}
+
+// checkReady runs the same checks as 'ygm tasks complete' before a card is
+// moved to Done. Listed tasks lack the selected copy, so it is fetched.
+func (b *board) checkReady(id int) error {
+	task, err := b.client.GetTask(id)
+	if err != nil {
+		return err
+	}
+	if missing := missingForCompletion(task); len(missing) > 0 {
+		return fmt.Errorf("not ready: %s (use 'ygm tasks complete %d --force' to complete anyway)", strings.Join(missing, ", "), id)
+	}
+	return nil
+}
+
+// discard removes a task from the board and discards it in the background
+func (b *board) discard(id int) {
+	t := b.find(id)
+	if t == nil {
+		return
+	}
+	if b.saving[id] {
+		b.setMessage("Still saving #%d…", id)
+		return
+	}
+
+	prev := *t
+	b.remove(id)
+	b.saving[id] = true
+	b.setMessage("Discarding #%d…", id)
+
+	go func() {
+		_, err := b.client.DiscardTask(prev.ID, api.PreconditionFor(&prev))
+		b.events <- boardWrite{action: "discard", prev: prev, err: err}
+	}()
+}
+
+func (b *board) remove(id int) {
+	for i := range b.tasks {
+		if b.tasks[i].ID == id {
+			b.tasks = append(b.tasks[:i], b.tasks[i+1:]...)
+			break
+		}
+	}
+	b.clampCursor()
+}
+
+// finishWrite applies the result of a background change, undoing the
+// optimistic update if it failed
+func (b *board) finishWrite(ev boardWrite) {
+	id := ev.prev.ID
+	delete(b.saving, id)
+	delete(b.details, id)
+
+	if ev.err != nil {
+		if t := b.find(id); t != nil {
+			*t = ev.prev
+		} else {
+			b.tasks = append(b.tasks, ev.prev)
+		}
+		b.clampCursor()
+
+		var conflict *api.ConflictError
+		if errors.As(ev.err, &conflict) {
+			b.setError("Couldn't %s #%d: it was changed by someone else; reloading", ev.action, id)
+			b.refresh()
+		} else {
+			b.setError("Couldn't %s #%d: %v", ev.action, id, ev.err)
+		}
+		return
+	}
+
+	switch ev.action {
+	case "move":
+		if t := b.find(id); t != nil && ev.task != nil {
+			*t = *ev.task
+		}
+		b.setMessage("Moved #%d to %s", id, statusLabel(ev.task))
+	case "discard":
+		b.setMessage("Discarded #%d (restore with 'ygm tasks restore %d')", id, id)
+	}
+}
+
+func statusLabel(t *api.Task) string {
+	if t == nil {
+		return "?"
+	}
+	for _, col := range boardColumns {
+		if slices.Contains(col.Statuses, t.Status) {
+			return col.Title
+		}
+	}
+	return t.Status
+}
+
+func (b *board) setMessage(format string, args ...interface{}) {
+	b.message = fmt.Sprintf(format, args...)
+	b.errorMsg = false
+}
+
+func (b *board) setError(format string, args ...interface{}) {
+	b.message = fmt.Sprintf(format, args...)
+	b.errorMsg = true
+}
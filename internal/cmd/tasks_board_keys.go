@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"unicode/utf8"
+)
+
+// readKeys turns terminal input into key events until stdin is closed
+func (b *board) readKeys() {
+	buf := make([]byte, 256)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			b.events <- boardKey("ctrl-c")
+			return
+		}
+		for _, key := range parseKeys(buf[:n]) {
+			b.events <- boardKey(key)
+		}
+	}
+}
+
+// escapeKeys maps the final byte of CSI sequences (ESC [ x) to key names
+var escapeKeys = map[byte]string{
+	'A': "up",
+	'B': "down",
+	'C': "right",
+	'D': "left",
+	'H': "home",
+	'F': "end",
+}
+
+// parseKeys splits raw terminal input into key names. Printable keys are
+// returned as themselves; unknown escape sequences are dropped.
+func parseKeys(data []byte) []string {
+	var keys []string
+	for len(data) > 0 {
+		switch c := data[0]; {
+		case c == 0x1b:
+			if len(data) == 1 {
+				keys = append(keys, "esc")
+				return keys
+			}
+			if data[1] != '[' && data[1] != 'O' {
+				keys = append(keys, "esc")
+				data = data[1:]
+				continue
+			}
+			// Skip parameters up to the final byte of the sequence
+			i := 2
+			for i < len(data) && (data[i] < 0x40 || data[i] > 0x7e) {
+				i++
+			}
+			if i < len(data) {
+				if key, ok := escapeKeys[data[i]]; ok {
+					keys = append(keys, key)
+				} else if data[i] == '~' && i == 3 {
+					switch data[2] {
+					case '1', '7':
+						keys = append(keys, "home")
+					case '4', '8':
+						keys = append(keys, "end")
+					}
+				}
+				i++
+			}
+			data = data[i:]
+		case c == 0x03:
+			keys = append(keys, "ctrl-c")
+			data = data[1:]
+		case c == '\r' || c == '\n':
+			keys = append(keys, "enter")
+			data = data[1:]
+		case c < 0x20 || c == 0x7f:
+			data = data[1:] // Other control keys aren't used
+		default:
+			r, size := utf8.DecodeRune(data)
+			keys = append(keys, string(r))
+			data = data[size:]
+		}
+	}
+	return keys
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+// TestBoardMoveToDoneChecksReadiness checks a card is only completed from
+// the board if it passes the 'tasks complete' checks
+func TestBoardMoveToDoneChecksReadiness(t *testing.T) {
+	tests := []struct {
+		name      string
+		task      string
+		wantErr   string
+		wantPatch bool
+	}{
+		{
+			name:    "not ready",
+			task:    `{"id":42,"title":"Launch","status":"in_progress","asset_type":"copy"}`,
+			wantErr: "not ready: no selected copy",
+		},
+		{
+			name: "ready",
+			task: `{"id":42,"title":"Launch","status":"in_progress","asset_type":"copy",` +
+				`"selected_copy":{"id":1,"content":"We launched!"},"suggested_post_date":"2026-11-02",` +
+				`"ready_for_completion":true}`,
+			wantPatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPatch {
+					patched = true
+					fmt.Fprint(w, `{"id":42,"title":"Launch","status":"completed"}`)
+					return
+				}
+				fmt.Fprint(w, tt.task)
+			}))
+			defer server.Close()
+
+			b := &board{
+				client: api.NewClient(server.URL, "ygm_test"),
+				events: make(chan interface{}, 1),
+				tasks:  []api.Task{{ID: 42, Title: "Launch", Status: "in_progress"}},
+				saving: make(map[int]bool),
+				col:    1,
+			}
+			b.move(1)
+			ev := (<-b.events).(boardWrite)
+
+			switch {
+			case tt.wantErr == "" && ev.err != nil:
+				t.Fatalf("move: %v", ev.err)
+			case tt.wantErr != "" && (ev.err == nil || !strings.Contains(ev.err.Error(), tt.wantErr)):
+				t.Fatalf("move error = %v, want one containing %q", ev.err, tt.wantErr)
+			}
+			if patched != tt.wantPatch {
+				t.Errorf("PATCH sent = %v, want %v", patched, tt.wantPatch)
+			}
+		})
+	}
+}
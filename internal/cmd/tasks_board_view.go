@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Board layout
+const (
+	boardDetailWidth   = 44  // Width of the detail pane beside the columns
+	boardSideBySide    = 100 // Narrower terminals show details full-screen
+	boardMinWidth      = 40
+	boardMinHeight     = 8
+	boardLinesPerCard  = 2
+	boardColumnHeading = 2 // Title and underline
+)
+
+// render redraws the whole screen
+func (b *board) render() {
+	color := useColor()
+	width, height := b.width, b.height
+	var lines []string
+
+	if width < boardMinWidth || height < boardMinHeight {
+		lines = []string{clip("Terminal too small for the board; press q to quit", width)}
+	} else {
+		lines = append(lines, colorize(fit(b.headerText(), width), 7, color))
+
+		bodyHeight := height - 3
+		switch {
+		case !b.loaded:
+			body := []string{"", "  Loading tasks…"}
+			lines = append(lines, padLines(body, bodyHeight, width)...)
+		case b.detail && width < boardSideBySide:
+			lines = append(lines, padLines(b.detailLines(width, color), bodyHeight, width)...)
+		default:
+			area := width
+			if b.detail {
+				area = width - boardDetailWidth - 1
+			}
+			columns := b.columnLines(area, bodyHeight, color)
+			if b.detail {
+				detail := padLines(b.detailLines(boardDetailWidth, color), bodyHeight, boardDetailWidth)
+				for i := range columns {
+					columns[i] += "│" + detail[i]
+				}
+			}
+			lines = append(lines, columns...)
+		}
+
+		message := fit(b.message, width)
+		if b.errorMsg {
+			message = colorize(message, 31, color)
+		}
+		lines = append(lines, message)
+		lines = append(lines, colorize(fit(" ←→↑↓ select  </> move  ⏎ details  d discard  p platform  r refresh  q quit", width), 90, color))
+	}
+
+	var out strings.Builder
+	out.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i > 0 {
+			out.WriteString("\r\n")
+		}
+		out.WriteString(line)
+		out.WriteString("\x1b[K")
+	}
+	out.WriteString("\x1b[J")
+	os.Stdout.WriteString(out.String())
+}
+
+func (b *board) headerText() string {
+	platform := "all platforms"
+	if b.platform != "" {
+		platform = b.platform
+	}
+	text := fmt.Sprintf(" ygm tasks board · %s · %s · %d tasks", b.org, platform, len(b.tasks))
+	if !b.lastRefresh.IsZero() {
+		text += " · updated " + b.lastRefresh.Format("15:04:05")
+	}
+	if b.loading {
+		text += " · refreshing…"
+	}
+	return text
+}
+
+// columnLines renders the status columns side by side, height lines of
+// exactly width columns
+func (b *board) columnLines(width, height int, color bool) []string {
+	n := len(boardColumns)
+	colWidth := (width - (n - 1)) / n
+	extra := width - (n - 1) - colWidth*n // Give leftover columns to the last one
+
+	rendered := make([][]string, n)
+	for i := range boardColumns {
+		w := colWidth
+		if i == n-1 {
+			w += extra
+		}
+		rendered[i] = b.columnBody(i, w, height, color)
+	}
+
+	lines := make([]string, height)
+	for row := range lines {
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = rendered[i][row]
+		}
+		lines[row] = strings.Join(parts, "│")
+	}
+	return lines
+}
+
+// columnBody renders one column: its heading and as many cards as fit,
+// scrolled to keep the cursor visible
+func (b *board) columnBody(col, width, height int, color bool) []string {
+	tasks := b.column(col)
+	active := col == b.col
+
+	heading := fit(fmt.Sprintf(" %s (%d)", boardColumns[col].Title, len(tasks)), width)
+	if active {
+		heading = colorize(heading, 1, color)
+	}
+	lines := []string{heading, strings.Repeat("─", width)}
+
+	visible := (height - boardColumnHeading) / boardLinesPerCard
+	if visible < 1 {
+		visible = 1
+	}
+	row := b.row[col]
+	if row < b.offset[col] {
+		b.offset[col] = row
+	}
+	if row >= b.offset[col]+visible {
+		b.offset[col] = row - visible + 1
+	}
+	if b.offset[col] > len(tasks)-visible {
+		b.offset[col] = max(0, len(tasks)-visible)
+	}
+
+	if len(tasks) == 0 {
+		lines = append(lines, colorize(fit("  (none)", width), 90, color))
+	}
+	for i := b.offset[col]; i < len(tasks) && i < b.offset[col]+visible; i++ {
+		t := tasks[i]
+
+		// Mark the cursor as well as highlighting it, for NO_COLOR
+		marker := " "
+		if active && i == row {
+			marker = "▸"
+		}
+		title := fit(fmt.Sprintf("%s#%d %s", marker, t.ID, t.Title), width)
+		meta := t.Platform
+		if meta == "" {
+			meta = "general"
+		}
+		if t.SuggestedPostDate != nil {
+			meta += " · " + *t.SuggestedPostDate
+		}
+		if b.saving[t.ID] {
+			meta += " · saving…"
+		}
+		meta = fit("   "+meta, width)
+
+		if active && i == row {
+			lines = append(lines, colorize(title, 7, color), colorize(meta, 7, color))
+		} else {
+			lines = append(lines, title, colorize(meta, platformColor(t.Platform), color))
+		}
+	}
+
+	// Show a scroll hint when there are more cards below
+	if below := len(tasks) - (b.offset[col] + visible); below > 0 {
+		hint := colorize(fit(fmt.Sprintf("  ↓ %d more", below), width), 90, color)
+		if len(lines) < height {
+			lines = append(lines, hint)
+		} else {
+			lines[height-1] = hint
+		}
+	}
+	return padLines(lines, height, width)
+}
+
+// detailLines renders the selected task's details
+func (b *board) detailLines(width int, color bool) []string {
+	t := b.selected()
+	if t == nil {
+		return []string{"", clip(" No task selected", width)}
+	}
+
+	detail, loaded := b.details[t.ID]
+	if detail == nil {
+		detail = t
+	}
+
+	inner := width - 2
+	lines := []string{colorize(fit(fmt.Sprintf(" #%d %s", t.ID, detail.Title), width), 1, color)}
+	field := func(name, value string) {
+		if value != "" {
+			lines = append(lines, fit(" "+name+": "+value, width))
+		}
+	}
+	field("Status", detail.Status)
+	field("Platform", detail.Platform)
+	field("Asset type", detail.AssetType)
+	if detail.SuggestedPostDate != nil {
+		field("Date", *detail.SuggestedPostDate)
+	}
+	if detail.ReadyForCompletion {
+		field("Ready", "yes")
+	}
+
+	section := func(name, text string) {
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		lines = append(lines, "", colorize(fit(" "+name, width), 1, color))
+		for _, l := range wrapText(text, inner) {
+			lines = append(lines, " "+l)
+		}
+	}
+	section("Description", detail.Description)
+	section("Image prompt", detail.ImagePrompt)
+	section("Copy prompt", detail.CopyPrompt)
+	section("Video prompt", detail.VideoPrompt)
+	if detail.SelectedCopy != nil {
+		section("Selected copy", detail.SelectedCopy.Content)
+	}
+
+	if loaded && b.details[t.ID] == nil {
+		lines = append(lines, "", colorize(fit(" Loading details…", width), 90, color))
+	}
+	return lines
+}
+
+// wrapText word-wraps text to width, keeping existing line breaks
+func wrapText(text string, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+	var lines []string
+	for _, para := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			switch {
+			case line == "":
+				line = word
+			case len([]rune(line))+1+len([]rune(word)) <= width:
+				line += " " + word
+			default:
+				lines = append(lines, clip(line, width))
+				line = word
+			}
+		}
+		lines = append(lines, clip(line, width))
+	}
+	return lines
+}
+
+// padLines makes lines exactly height long, padding each to width. Lines
+// already containing styles are assumed to be the right width.
+func padLines(lines []string, height, width int) []string {
+	out := make([]string, height)
+	for i := range out {
+		if i < len(lines) {
+			if strings.Contains(lines[i], "\x1b[") {
+				out[i] = lines[i]
+			} else {
+				out[i] = fit(lines[i], width)
+			}
+		} else {
+			out[i] = strings.Repeat(" ", width)
+		}
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskcache"
+	"github.com/spf13/cobra"
+)
+
+// Bounds for --concurrency on bulk task commands
+const (
+	defaultBulkConcurrency = 4
+	maxBulkConcurrency     = 16
+)
+
+// taskWhere selects tasks by field values, from --where
+type taskWhere struct {
+	Status    string
+	Platform  string
+	AssetType string
+}
+
+// whereKeys are the fields --where can match on
+var whereKeys = []string{"status", "platform", "asset_type"}
+
+// parseWhere parses a --where value like "status=pending,platform=twitter"
+func parseWhere(value string) (taskWhere, error) {
+	var w taskWhere
+	for _, pair := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok || key == "" || val == "" {
+			return w, fmt.Errorf("invalid --where condition '%s' (expected key=value)", pair)
+		}
+
+		switch key {
+		case "status":
+			if !slices.Contains(config.TaskStatuses, val) {
+				return w, fmt.Errorf("invalid status '%s' in --where (expected one of: %s)", val, strings.Join(config.TaskStatuses, ", "))
+			}
+			w.Status = val
+		case "platform":
+			w.Platform = val
+		case "asset_type":
+			if !slices.Contains(config.AssetTypes, val) {
+				return w, fmt.Errorf("invalid asset_type '%s' in --where (expected one of: %s)", val, strings.Join(config.AssetTypes, ", "))
+			}
+			w.AssetType = val
+		default:
+			return w, fmt.Errorf("unknown --where key '%s' (expected one of: %s)", key, strings.Join(whereKeys, ", "))
+		}
+	}
+	return w, nil
+}
+
+// matches reports whether a task satisfies every condition
+func (w taskWhere) matches(t api.Task) bool {
+	return (w.Status == "" || t.Status == w.Status) &&
+		(w.Platform == "" || t.Platform == w.Platform) &&
+		(w.AssetType == "" || t.AssetType == w.AssetType)
+}
+
+// selectTaskIDs resolves the tasks a bulk command applies to, from ID
+// arguments or a --where filter. Tasks selected by --where are remembered in
+// cache, so later changes are conditional on the version just listed.
+func selectTaskIDs(client *api.Client, args []string, where string, cache *taskcache.Cache) ([]int, error) {
+	if where == "" {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("give one or more task IDs or --where")
+		}
+		return parseTaskIDs(args)
+	}
+	if len(args) > 0 {
+		return nil, fmt.Errorf("give task IDs or --where, not both")
+	}
+
+	w, err := parseWhere(where)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := client.GetTasks(api.TaskFilter{Status: w.Status, Platform: w.Platform})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	ids := []int{}
+	for _, t := range tasks {
+		// The API only filters on status and platform
+		if w.matches(t) {
+			ids = append(ids, t.ID)
+			cache.Remember(t)
+		}
+	}
+	return ids, nil
+}
+
+// bulkResult is the outcome for one task of a bulk command
+type bulkResult struct {
+	ID    int       `json:"id"`
+	OK    bool      `json:"ok"`
+	Task  *api.Task `json:"task,omitempty"`
+	Error string    `json:"error,omitempty"`
+}
+
+// bulkError describes a failed task for the results table, pointing at the
+// override flag when the task changed since it was last read
+func bulkError(err error, forceFlag string) string {
+	var conflict *api.ConflictError
+	if errors.As(err, &conflict) {
+		return fmt.Sprintf("changed since last read (use %s to override)", forceFlag)
+	}
+	return err.Error()
+}
+
+// addBulkFlags registers the flags shared by bulk task commands
+func addBulkFlags(cmd *cobra.Command, where *string, concurrency *int) {
+	cmd.Flags().StringVar(where, "where", "", "Select tasks by field, e.g. status=pending,platform=twitter (keys: "+strings.Join(whereKeys, ", ")+")")
+	cmd.Flags().IntVar(concurrency, "concurrency", defaultBulkConcurrency, fmt.Sprintf("Number of tasks to process at once (1-%d)", maxBulkConcurrency))
+}
+
+// runBulk applies fn to every task ID using a pool of concurrency workers.
+// With --json each result is printed as a line of JSON as soon as it is
+// done; otherwise a table is printed at the end. Returns an error if any
+// task failed.
+func runBulk(ids []int, concurrency int, fn func(id int) bulkResult) error {
+	if concurrency < 1 || concurrency > maxBulkConcurrency {
+		return fmt.Errorf("--concurrency must be between 1 and %d", maxBulkConcurrency)
+	}
+	if len(ids) == 0 {
+		if !jsonOutput {
+			fmt.Println("No tasks selected.")
+		}
+		return nil
+	}
+
+	jobs := make(chan int)
+	results := make(chan bulkResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				results <- fn(id)
+			}
+		}()
+	}
+	go func() {
+		for _, id := range ids {
+			jobs <- id
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	collected := make([]bulkResult, 0, len(ids))
+	failed := []taskFailure{}
+	for r := range results {
+		if jsonOutput {
+			enc.Encode(r)
+		}
+		if !r.OK {
+			failed = append(failed, taskFailure{ID: r.ID, Error: r.Error})
+		}
+		collected = append(collected, r)
+	}
+
+	if !jsonOutput {
+		outputBulkTable(ids, collected)
+	}
+
+	return failedTasksError(failed, len(ids))
+}
+
+// outputBulkTable prints results in the order the tasks were given
+func outputBulkTable(ids []int, results []bulkResult) {
+	order := make(map[int]int, len(ids))
+	for i, id := range ids {
+		order[id] = i
+	}
+	sort.Slice(results, func(i, j int) bool { return order[results[i].ID] < order[results[j].ID] })
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tRESULT\tDETAILS")
+	for _, r := range results {
+		if r.OK {
+			title := ""
+			if r.Task != nil {
+				title = r.Task.Title
+			}
+			fmt.Fprintf(w, "%d\tok\t%s\n", r.ID, clip(title, 60))
+		} else {
+			fmt.Fprintf(w, "%d\tfailed\t%s\n", r.ID, r.Error)
+		}
+	}
+	w.Flush()
+}
@@ -0,0 +1,408 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	calendarMonth    string
+	calendarWeek     string
+	calendarStatus   string
+	calendarPlatform string
+)
+
+var tasksCalendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Show scheduled tasks in a calendar",
+	Long: `Show tasks on their suggested post dates in a month or week calendar.
+
+Tasks are colored by platform. Tasks without a date are listed beside the
+calendar, or below it when there isn't room. On narrow terminals the calendar
+is shown as a list of days instead of a grid.
+
+--month defaults to the current month. --week shows the current week, or
+with --week=DATE the week containing DATE; weeks start on Monday.
+
+Set NO_COLOR to disable colors, or COLUMNS to override the terminal width.
+
+Examples:
+  ygm tasks calendar
+  ygm tasks calendar --month 2026-11
+  ygm tasks calendar --week
+  ygm tasks calendar --week=2026-11-03 --platform twitter
+  ygm tasks calendar --month 2026-11 --json`,
+	Args: cobra.NoArgs,
+	RunE: runTasksCalendar,
+}
+
+func init() {
+	tasksCalendarCmd.Flags().StringVar(&calendarMonth, "month", "", "Month to show (YYYY-MM, default: this month)")
+	tasksCalendarCmd.Flags().StringVar(&calendarWeek, "week", "", "Show a week instead of a month; --week=YYYY-MM-DD picks the week containing that date")
+	tasksCalendarCmd.Flags().Lookup("week").NoOptDefVal = "today"
+	tasksCalendarCmd.Flags().StringVar(&calendarStatus, "status", "", "Filter by status (pending, in_progress, completed)")
+	tasksCalendarCmd.Flags().StringVar(&calendarPlatform, "platform", "", "Filter by platform (instagram, twitter, linkedin, etc.)")
+	tasksCalendarCmd.MarkFlagsMutuallyExclusive("month", "week")
+}
+
+// Layout limits for the calendar grid
+const (
+	minCalendarCell   = 10 // Narrower cells fall back to the day list
+	maxCalendarCell   = 24
+	calendarSideWidth = 32
+	maxTasksPerCell   = 3 // In month view; the week view shows every task
+)
+
+// calendarDay is one day of the calendar with the tasks due on it
+type calendarDay struct {
+	Date  string     `json:"date"`
+	Tasks []api.Task `json:"tasks"`
+}
+
+// calendarView is the range shown and the tasks placed in it
+type calendarView struct {
+	View        string        `json:"view"` // "month" or "week"
+	Start       string        `json:"start"`
+	End         string        `json:"end"`
+	Days        []calendarDay `json:"days"`
+	Unscheduled []api.Task    `json:"unscheduled"`
+
+	title    string
+	first    time.Time // First day of the range
+	last     time.Time // Last day of the range
+	gridFrom time.Time // Monday on or before first
+	gridTo   time.Time // Sunday on or after last
+	byDate   map[string][]api.Task
+}
+
+func runTasksCalendar(cmd *cobra.Command, args []string) error {
+	view, err := newCalendarView(time.Now())
+	if err != nil {
+		return err
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	tasks, err := client.GetTasks(api.TaskFilter{Status: calendarStatus, Platform: calendarPlatform})
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	view.place(tasks)
+
+	if jsonOutput {
+		return outputJSON(view)
+	}
+
+	color := useColor()
+	width := terminalWidth()
+	var lines []string
+	if (width-8)/7 < minCalendarCell {
+		lines = view.renderList(color)
+	} else {
+		lines = view.renderGrid(width, color)
+	}
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// newCalendarView works out the range to show from --month and --week
+func newCalendarView(now time.Time) (*calendarView, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	v := &calendarView{byDate: make(map[string][]api.Task)}
+
+	if calendarWeek != "" {
+		day := today
+		if calendarWeek != "today" {
+			var err error
+			if day, err = time.Parse("2006-01-02", calendarWeek); err != nil {
+				return nil, fmt.Errorf("invalid --week date '%s' (expected YYYY-MM-DD)", calendarWeek)
+			}
+		}
+		v.View = "week"
+		v.first = startOfWeek(day)
+		v.last = v.first.AddDate(0, 0, 6)
+		v.title = fmt.Sprintf("Week of %s – %s", v.first.Format("Mon Jan 2"), v.last.Format("Mon Jan 2, 2006"))
+	} else {
+		month := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if calendarMonth != "" {
+			var err error
+			if month, err = time.Parse("2006-01", calendarMonth); err != nil {
+				return nil, fmt.Errorf("invalid --month '%s' (expected YYYY-MM)", calendarMonth)
+			}
+		}
+		v.View = "month"
+		v.first = month
+		v.last = month.AddDate(0, 1, -1)
+		v.title = month.Format("January 2006")
+	}
+
+	v.gridFrom = startOfWeek(v.first)
+	v.gridTo = startOfWeek(v.last).AddDate(0, 0, 6)
+	v.Start = v.first.Format("2006-01-02")
+	v.End = v.last.Format("2006-01-02")
+	return v, nil
+}
+
+// startOfWeek returns the Monday on or before day
+func startOfWeek(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// place puts tasks on their days. Tasks dated outside the range are left
+// out; tasks without a date are unscheduled.
+func (v *calendarView) place(tasks []api.Task) {
+	v.Unscheduled = []api.Task{}
+	for _, t := range tasks {
+		if t.SuggestedPostDate == nil || *t.SuggestedPostDate == "" {
+			v.Unscheduled = append(v.Unscheduled, t)
+			continue
+		}
+		v.byDate[*t.SuggestedPostDate] = append(v.byDate[*t.SuggestedPostDate], t)
+	}
+	for date := range v.byDate {
+		sort.SliceStable(v.byDate[date], func(i, j int) bool {
+			return v.byDate[date][i].Position < v.byDate[date][j].Position
+		})
+	}
+
+	v.Days = []calendarDay{}
+	for day := v.first; !day.After(v.last); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		tasks := v.byDate[date]
+		if tasks == nil {
+			tasks = []api.Task{}
+		}
+		v.Days = append(v.Days, calendarDay{Date: date, Tasks: tasks})
+	}
+}
+
+// inRange reports whether day is part of the month or week being shown
+func (v *calendarView) inRange(day time.Time) bool {
+	return !day.Before(v.first) && !day.After(v.last)
+}
+
+// taskLabel is the short form of a task shown in the calendar
+func taskLabel(t api.Task) string {
+	label := fmt.Sprintf("#%d %s", t.ID, t.Title)
+	if t.Status == "completed" || t.Status == "shared" {
+		label = "✓ " + label
+	}
+	return label
+}
+
+// renderGrid draws the calendar as a grid of days, with unscheduled tasks
+// beside it if the terminal is wide enough and below it otherwise
+func (v *calendarView) renderGrid(width int, color bool) []string {
+	side := len(v.Unscheduled) > 0 && width >= 7*(minCalendarCell+4)+8+calendarSideWidth+2
+	gridWidth := width
+	if side {
+		gridWidth -= calendarSideWidth + 2
+	}
+	cell := (gridWidth - 8) / 7
+	if cell > maxCalendarCell {
+		cell = maxCalendarCell
+	}
+
+	border := "+" + strings.Repeat(strings.Repeat("-", cell)+"+", 7)
+	row := func(cells []string) string {
+		return "|" + strings.Join(cells, "|") + "|"
+	}
+
+	var grid []string
+	grid = append(grid, v.title, "")
+	header := make([]string, 7)
+	for i := range header {
+		header[i] = fit(" "+v.gridFrom.AddDate(0, 0, i).Format("Mon"), cell)
+	}
+	grid = append(grid, border, row(header), border)
+
+	today := time.Now().Format("2006-01-02")
+	for week := v.gridFrom; !week.After(v.gridTo); week = week.AddDate(0, 0, 7) {
+		// Work out how many task lines this week needs
+		lines := 0
+		for i := 0; i < 7; i++ {
+			day := week.AddDate(0, 0, i)
+			if n := len(v.byDate[day.Format("2006-01-02")]); v.inRange(day) && n > lines {
+				lines = n
+			}
+		}
+		if v.View == "month" && lines > maxTasksPerCell {
+			lines = maxTasksPerCell
+		}
+
+		numbers := make([]string, 7)
+		for i := range numbers {
+			day := week.AddDate(0, 0, i)
+			label := fit(fmt.Sprintf(" %d", day.Day()), cell)
+			switch {
+			case !v.inRange(day):
+				label = colorize(label, 90, color) // Dim days outside the month
+			case day.Format("2006-01-02") == today:
+				label = colorize(label, 7, color) // Reverse video for today
+			}
+			numbers[i] = label
+		}
+		grid = append(grid, row(numbers))
+
+		for l := 0; l < lines; l++ {
+			cells := make([]string, 7)
+			for i := range cells {
+				day := week.AddDate(0, 0, i)
+				tasks := v.byDate[day.Format("2006-01-02")]
+				if !v.inRange(day) {
+					tasks = nil
+				}
+				switch {
+				case l >= len(tasks):
+					cells[i] = fit("", cell)
+				case l == lines-1 && len(tasks) > lines:
+					cells[i] = fit(fmt.Sprintf(" +%d more", len(tasks)-l), cell)
+				default:
+					t := tasks[l]
+					cells[i] = colorize(fit(" "+taskLabel(t), cell), platformColor(t.Platform), color)
+				}
+			}
+			grid = append(grid, row(cells))
+		}
+		grid = append(grid, border)
+	}
+
+	legend := v.legend(color)
+	if !side {
+		if len(v.Unscheduled) > 0 {
+			grid = append(grid, "")
+			grid = append(grid, v.unscheduledLines(width, color)...)
+		}
+		if legend != "" {
+			grid = append(grid, "", legend)
+		}
+		return grid
+	}
+
+	// Put the unscheduled list to the right of the grid
+	list := v.unscheduledLines(calendarSideWidth, color)
+	gridLineWidth := 8 + 7*cell
+	out := make([]string, 0, len(grid))
+	for i, line := range grid {
+		if i >= 2 && i-2 < len(list) {
+			pad := gridLineWidth - visibleWidth(line)
+			if pad < 0 {
+				pad = 0
+			}
+			line += strings.Repeat(" ", pad+2) + list[i-2]
+		}
+		out = append(out, line)
+	}
+	for i := len(grid) - 2; i < len(list); i++ {
+		out = append(out, strings.Repeat(" ", gridLineWidth+2)+list[i])
+	}
+	if legend != "" {
+		out = append(out, "", legend)
+	}
+	return out
+}
+
+// renderList draws the calendar as a list of days with tasks, for terminals
+// too narrow for a grid
+func (v *calendarView) renderList(color bool) []string {
+	width := terminalWidth()
+	lines := []string{v.title, ""}
+
+	empty := true
+	for _, d := range v.Days {
+		if len(d.Tasks) == 0 {
+			continue
+		}
+		empty = false
+		day, _ := time.Parse("2006-01-02", d.Date)
+		prefix := day.Format("Mon 02")
+		for _, t := range d.Tasks {
+			label := taskLabel(t)
+			if t.Platform != "" {
+				label += " (" + t.Platform + ")"
+			}
+			lines = append(lines, prefix+"  "+colorize(clip(label, width-len(prefix)-2), platformColor(t.Platform), color))
+			prefix = strings.Repeat(" ", len(prefix))
+		}
+	}
+	if empty {
+		lines = append(lines, "No scheduled tasks.")
+	}
+
+	if len(v.Unscheduled) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, v.unscheduledLines(width, color)...)
+	}
+	return lines
+}
+
+// unscheduledLines lists tasks without a date, clipped to width
+func (v *calendarView) unscheduledLines(width int, color bool) []string {
+	lines := []string{fmt.Sprintf("Unscheduled (%d)", len(v.Unscheduled))}
+	for _, t := range v.Unscheduled {
+		lines = append(lines, colorize(clip("  "+taskLabel(t), width), platformColor(t.Platform), color))
+	}
+	return lines
+}
+
+// legend shows the color of each platform on the calendar
+func (v *calendarView) legend(color bool) string {
+	if !color {
+		return ""
+	}
+	seen := make(map[string]bool)
+	var platforms []string
+	add := func(tasks []api.Task) {
+		for _, t := range tasks {
+			if t.Platform != "" && !seen[t.Platform] {
+				seen[t.Platform] = true
+				platforms = append(platforms, t.Platform)
+			}
+		}
+	}
+	for _, d := range v.Days {
+		add(d.Tasks)
+	}
+	add(v.Unscheduled)
+	if len(platforms) == 0 {
+		return ""
+	}
+
+	sort.Strings(platforms)
+	for i, p := range platforms {
+		platforms[i] = colorize("■ "+p, platformColor(p), true)
+	}
+	return "Platforms: " + strings.Join(platforms, "  ")
+}
+
+// visibleWidth counts the columns of s, skipping ANSI escape sequences
+func visibleWidth(s string) int {
+	n := 0
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case inEscape:
+			if r == 'm' {
+				inEscape = false
+			}
+		case r == '\x1b':
+			inEscape = true
+		default:
+			n++
+		}
+	}
+	return n
+}
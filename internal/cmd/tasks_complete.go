@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksCompleteCmd = &cobra.Command{
+	Use:   "complete <id>...",
+	Short: "Mark tasks as completed once they're ready",
+	Long: `Mark tasks as completed, checking first that they are ready: they need
+selected copy, a post date and, for image tasks, selected images. Tasks that
+aren't ready are left alone and what's missing is explained; use --force to
+complete them anyway. Either way, a task that someone changes while it is
+being completed is left alone.
+
+Shared tasks are already past completion, so they are left alone too; use
+'ygm tasks update <id> --status completed' to move one back.
+
+--url records where the task was published, and --published-at when
+(RFC 3339, YYYY-MM-DD or "now"; defaults to now when --url is given).
+
+Examples:
+  ygm tasks complete 42
+  ygm tasks complete 42 43 50-55
+  ygm tasks complete 42 --url https://x.com/acme/status/123
+  ygm tasks complete 42 --url https://acme.com/blog/launch --published-at 2026-10-16
+  ygm tasks complete 42 --force --json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTasksComplete,
+}
+
+var (
+	completeForce       bool
+	completeURL         string
+	completePublishedAt string
+)
+
+func init() {
+	tasksCompleteCmd.Flags().BoolVar(&completeForce, "force", false, "Complete tasks even if they aren't ready")
+	tasksCompleteCmd.Flags().StringVar(&completeURL, "url", "", "URL where the task was published")
+	tasksCompleteCmd.Flags().StringVar(&completePublishedAt, "published-at", "", "When the task was published (RFC 3339, YYYY-MM-DD or now)")
+}
+
+func runTasksComplete(cmd *cobra.Command, args []string) error {
+	ids, err := parseTaskIDs(args)
+	if err != nil {
+		return err
+	}
+
+	req := api.UpdateTaskRequest{Status: api.Set("completed")}
+	if completeURL != "" {
+		if len(ids) > 1 {
+			return fmt.Errorf("--url can only be given for a single task")
+		}
+		if u, err := url.Parse(completeURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid --url %q (expected an http or https URL)", completeURL)
+		}
+		req.PublishedURL = api.Set(completeURL)
+		if completePublishedAt == "" {
+			completePublishedAt = "now"
+		}
+	}
+	if completePublishedAt != "" {
+		published, err := parsePublishedAt(completePublishedAt)
+		if err != nil {
+			return err
+		}
+		req.PublishedAt = api.Set(published.Format(time.RFC3339))
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	cache := loadTaskCache()
+
+	completed := []api.Task{}
+	failed := []taskFailure{}
+	for _, id := range ids {
+		task, err := completeTask(client, id, req)
+		if err != nil {
+			failed = append(failed, taskFailure{ID: id, Error: err.Error()})
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "Not completing task #%d: %v\n", id, err)
+			}
+			continue
+		}
+		cache.Remember(*task)
+		completed = append(completed, *task)
+		if !jsonOutput {
+			fmt.Printf("Completed task #%d: %s\n", task.ID, task.Title)
+		}
+	}
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"completed": completed, "failed": failed}); err != nil {
+			return err
+		}
+	}
+
+	return failedTasksError(failed, len(ids))
+}
+
+// completeTask checks that a task is ready and completes it. Shared tasks
+// are refused rather than moved back to completed.
+func completeTask(client *api.Client, id int, req api.UpdateTaskRequest) (*api.Task, error) {
+	task, err := client.GetTask(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	if task.Status == "shared" {
+		return nil, fmt.Errorf("already shared; completing it would move it back to completed")
+	}
+	if !completeForce {
+		if missing := missingForCompletion(task); len(missing) > 0 {
+			return nil, fmt.Errorf("not ready: %s (use --force to complete anyway)", strings.Join(missing, ", "))
+		}
+	}
+
+	// Even with --force, only complete the version that was just checked
+	updated, err := client.UpdateTask(id, req, api.PreconditionFor(task))
+	var conflict *api.ConflictError
+	if errors.As(err, &conflict) {
+		return nil, fmt.Errorf("task changed while completing it; try again")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return updated, nil
+}
+
+// missingForCompletion lists what a task still needs before it can be
+// completed. The API's ReadyForCompletion has the final say; if it disagrees
+// with these checks, the task is still reported as not ready.
+func missingForCompletion(t *api.Task) []string {
+	var missing []string
+	if t.SelectedCopy == nil || strings.TrimSpace(t.SelectedCopy.Content) == "" {
+		missing = append(missing, fmt.Sprintf("no selected copy (see 'ygm tasks copy %d')", t.ID))
+	}
+	if t.AssetType == "image" && t.SelectedImagesCount == 0 {
+		missing = append(missing, fmt.Sprintf("no selected images (see 'ygm tasks images %d')", t.ID))
+	}
+	if t.SuggestedPostDate == nil {
+		missing = append(missing, "no post date")
+	}
+	if len(missing) == 0 && !t.ReadyForCompletion {
+		missing = append(missing, "the web app reports it isn't ready for completion")
+	}
+	return missing
+}
+
+// parsePublishedAt parses --published-at. Dates without a time are taken as
+// midnight local time.
+func parsePublishedAt(value string) (time.Time, error) {
+	if value == "now" {
+		return time.Now(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --published-at %q (expected RFC 3339, YYYY-MM-DD or now)", value)
+}
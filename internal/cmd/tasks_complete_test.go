@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestTasksComplete checks --force only skips the readiness check: the
+// update is still conditional, and shared tasks are still refused
+func TestTasksComplete(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		args        []string
+		wantErr     bool
+		wantIfMatch string // Empty if no PATCH should be sent
+	}{
+		{name: "not ready", status: "pending", wantErr: true},
+		{name: "force", status: "pending", args: []string{"--force"}, wantIfMatch: `"v1"`},
+		{name: "shared", status: "shared", wantErr: true},
+		{name: "shared with force", status: "shared", args: []string{"--force"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ifMatch string
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("ETag", `"v1"`)
+				if r.Method == http.MethodPatch {
+					patched, ifMatch = true, r.Header.Get("If-Match")
+					fmt.Fprint(w, `{"id":42,"title":"Launch","status":"completed"}`)
+					return
+				}
+				fmt.Fprintf(w, `{"id":42,"title":"Launch","status":%q}`, tt.status)
+			}))
+			defer server.Close()
+
+			useTestAccount(t, server.URL)
+			args := append([]string{"tasks", "complete", "42"}, tt.args...)
+			err := executeCommand(t, args...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ygm %v: error = %v, want error %v", args, err, tt.wantErr)
+			}
+			if patched != (tt.wantIfMatch != "") {
+				t.Fatalf("PATCH sent = %v, want %v", patched, tt.wantIfMatch != "")
+			}
+			if ifMatch != tt.wantIfMatch {
+				t.Errorf("If-Match = %q, want %q", ifMatch, tt.wantIfMatch)
+			}
+		})
+	}
+}
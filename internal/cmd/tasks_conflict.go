@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskcache"
+)
+
+// loadTaskCache returns the cache of last-read task versions for the active org
+func loadTaskCache() *taskcache.Cache {
+	org, _ := resolveOrg()
+	return taskcache.Load(org)
+}
+
+// saveTaskCache writes the cache, warning rather than failing since the
+// command itself succeeded
+func saveTaskCache(cache *taskcache.Cache) {
+	if err := cache.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not save task cache: %v\n", err)
+	}
+}
+
+// explainConflict reports a conflicting write by showing the version that
+// was read and the attempted changes next to the server's current values,
+// and returns the error to exit with, ending in retry (e.g. "re-run with
+// --force to overwrite"). Other errors are returned unchanged. A discard
+// changes no fields, so for an empty req the server's main fields are shown
+// instead.
+func explainConflict(err error, req api.UpdateTaskRequest, read *api.Precondition, retry string) error {
+	var conflict *api.ConflictError
+	if !errors.As(err, &conflict) {
+		return err
+	}
+
+	fields := req.Fields()
+	names := make([]string, 0, len(fields))
+	for name, f := range fields {
+		if f.IsSet() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		names = []string{"title", "status", "platform", "suggested_post_date"}
+	}
+
+	readAt, serverAt := "?", "?"
+	if read != nil && !read.UnmodifiedSince.IsZero() {
+		readAt = read.UnmodifiedSince.Local().Format("2006-01-02 15:04:05")
+	}
+	if conflict.Current != nil {
+		serverAt = conflict.Current.UpdatedAt.Local().Format("2006-01-02 15:04:05")
+	}
+
+	if jsonOutput {
+		server := map[string]string{}
+		if conflict.Current != nil {
+			for _, name := range names {
+				server[name] = taskField(conflict.Current, name)
+			}
+		}
+		result := map[string]interface{}{
+			"error":   "conflict",
+			"task_id": conflict.TaskID,
+			"server":  server,
+			"current": conflict.Current,
+		}
+		if read != nil {
+			result["your_version"] = map[string]interface{}{
+				"etag":       read.ETag,
+				"updated_at": read.UnmodifiedSince,
+			}
+		}
+		if !req.IsEmpty() {
+			result["your_changes"] = req
+		}
+		outputJSON(result)
+	} else {
+		fmt.Fprintln(os.Stderr, conflict.Error())
+		fmt.Fprintln(os.Stderr)
+		w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "FIELD\tYOURS\tSERVER")
+		fmt.Fprintf(w, "%s\t%s\t%s\n", "updated_at", readAt, serverAt)
+		for _, name := range names {
+			yours := "-" // Not changed by this command
+			if f := fields[name]; f.IsSet() {
+				yours = "(cleared)"
+				if value, ok := f.Get(); ok {
+					yours = value
+				}
+			}
+			server := "?"
+			if conflict.Current != nil {
+				server = taskField(conflict.Current, name)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", name, clip(yours, 40), clip(server, 40))
+		}
+		w.Flush()
+		fmt.Fprintln(os.Stderr)
+	}
+
+	return fmt.Errorf("%w; %s", conflict, retry)
+}
+
+// taskField returns a task field by its JSON name, for display
+func taskField(t *api.Task, name string) string {
+	switch name {
+	case "title":
+		return t.Title
+	case "description":
+		return t.Description
+	case "status":
+		return t.Status
+	case "platform":
+		return t.Platform
+	case "asset_type":
+		return t.AssetType
+	case "suggested_post_date":
+		if t.SuggestedPostDate != nil {
+			return *t.SuggestedPostDate
+		}
+	case "image_prompt":
+		return t.ImagePrompt
+	case "copy_prompt":
+		return t.CopyPrompt
+	case "video_prompt":
+		return t.VideoPrompt
+	case "published_url":
+		return t.PublishedURL
+	case "published_at":
+		if t.PublishedAt != nil {
+			return t.PublishedAt.Format(time.RFC3339)
+		}
+	}
+	return ""
+}
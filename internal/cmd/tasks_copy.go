@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksCopyCmd = &cobra.Command{
+	Use:   "copy <id>",
+	Short: "Show, upload or select a task's copy",
+	Long: `Work with the copy written for a task.
+
+Without flags, prints the task's selected copy so it can be piped or
+redirected. --set uploads new copy and selects it; pass '-' to read it from
+stdin. --list shows every copy variant, and --select picks one of them by
+its copy ID.
+
+Examples:
+  ygm tasks copy 42
+  ygm tasks copy 42 > draft.md
+  ygm tasks copy 42 --set-file draft.md
+  pbpaste | ygm tasks copy 42 --set -
+  ygm tasks copy 42 --list
+  ygm tasks copy 42 --select 7`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksCopy,
+}
+
+var (
+	copySet     string
+	copySetFile string
+	copyList    bool
+	copySelect  int
+)
+
+func init() {
+	tasksCopyCmd.Flags().StringVar(&copySet, "set", "", "Upload this text as the selected copy ('-' reads stdin)")
+	tasksCopyCmd.Flags().StringVar(&copySetFile, "set-file", "", "Upload the contents of a file as the selected copy")
+	tasksCopyCmd.Flags().BoolVar(&copyList, "list", false, "List all copy variants")
+	tasksCopyCmd.Flags().IntVar(&copySelect, "select", 0, "Make the copy variant with this ID the selected copy")
+	tasksCopyCmd.MarkFlagsMutuallyExclusive("set", "set-file", "list", "select")
+}
+
+func runTasksCopy(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	switch {
+	case cmd.Flags().Changed("set") || cmd.Flags().Changed("set-file"):
+		return setTaskCopy(client, id)
+	case copyList:
+		return listTaskCopies(client, id)
+	case cmd.Flags().Changed("select"):
+		return selectTaskCopy(client, id, copySelect)
+	}
+
+	task, err := client.GetTask(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"task_id": task.ID, "selected_copy": task.SelectedCopy})
+	}
+
+	if task.SelectedCopy == nil {
+		return fmt.Errorf("task #%d has no selected copy; see 'ygm tasks copy %d --list'", task.ID, task.ID)
+	}
+	fmt.Println(strings.TrimRight(task.SelectedCopy.Content, "\n"))
+	return nil
+}
+
+func setTaskCopy(client *api.Client, id int) error {
+	var content string
+	switch {
+	case copySetFile != "":
+		data, err := os.ReadFile(copySetFile)
+		if err != nil {
+			return fmt.Errorf("failed to read copy: %w", err)
+		}
+		content = string(data)
+	case copySet == "-":
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read copy: %w", err)
+		}
+		content = string(data)
+	default:
+		content = copySet
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return fmt.Errorf("copy is empty")
+	}
+
+	task, err := client.SetCopy(id, content)
+	if err != nil {
+		return fmt.Errorf("failed to save copy: %w", err)
+	}
+	rememberTask(task)
+
+	if jsonOutput {
+		return outputJSON(task)
+	}
+
+	fmt.Printf("Saved the selected copy for task #%d: %s\n", task.ID, task.Title)
+	return nil
+}
+
+func listTaskCopies(client *api.Client, id int) error {
+	copies, err := client.ListCopies(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch copy: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"task_id": id, "copies": copies})
+	}
+
+	if len(copies) == 0 {
+		fmt.Printf("No copy written for task #%d yet.\n", id)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  ID\tCREATED\tCOPY")
+	for _, c := range copies {
+		marker := " "
+		if c.Selected {
+			marker = "*"
+		}
+		created := ""
+		if !c.CreatedAt.IsZero() {
+			created = c.CreatedAt.Local().Format("2006-01-02 15:04")
+		}
+		preview := strings.Join(strings.Fields(c.Content), " ")
+		fmt.Fprintf(w, "%s %d\t%s\t%s\n", marker, c.ID, created, clip(preview, 60))
+	}
+	return w.Flush()
+}
+
+func selectTaskCopy(client *api.Client, id, copyID int) error {
+	task, err := client.SelectCopy(id, copyID)
+	if err != nil {
+		return fmt.Errorf("failed to select copy: %w", err)
+	}
+	rememberTask(task)
+
+	if jsonOutput {
+		return outputJSON(task)
+	}
+
+	fmt.Printf("Selected copy #%d for task #%d: %s\n", copyID, task.ID, task.Title)
+	return nil
+}
+
+// rememberTask records the version of a task the server just returned
+func rememberTask(task *api.Task) {
+	cache := loadTaskCache()
+	cache.Remember(*task)
+	saveTaskCache(cache)
+}
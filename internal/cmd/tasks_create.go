@@ -20,6 +20,8 @@ var tasksCreateCmd = &cobra.Command{
 	Short: "Create a new marketing task",
 	Long: `Create a new marketing task in your current marketing plan.
 
+--platform and --asset-type default to tasks.defaults in .ygm.yml.
+
 Examples:
   ygm tasks create --title "Post on Reddit" --platform reddit
   ygm tasks create --title "Launch tweet" --description "Announce v2" --platform twitter --date 2026-02-11
@@ -42,7 +44,7 @@ func runTasksCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
+	client := api.NewClient(account.APIURL, account.Token)
 
 	req := api.CreateTaskRequest{
 		Title:       taskTitle,
@@ -50,6 +52,16 @@ func runTasksCreate(cmd *cobra.Command, args []string) error {
 		Platform:    taskPlatform,
 		AssetType:   taskAssetType,
 	}
+
+	// Fall back to the project's defaults from .ygm.yml
+	if localCfg != nil {
+		if !cmd.Flags().Changed("platform") {
+			req.Platform = localCfg.Tasks.Defaults.Platform
+		}
+		if !cmd.Flags().Changed("asset-type") {
+			req.AssetType = localCfg.Tasks.Defaults.AssetType
+		}
+	}
 	if taskDate != "" {
 		req.SuggestedPostDate = &taskDate
 	}
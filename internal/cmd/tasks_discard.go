@@ -2,41 +2,89 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskcache"
 	"github.com/spf13/cobra"
 )
 
 var tasksDiscardCmd = &cobra.Command{
-	Use:   "discard <id>",
-	Short: "Discard a marketing task",
-	Long: `Soft-delete a marketing task. The task can be restored later if needed.
+	Use:   "discard <id>... | --where <conditions>",
+	Short: "Discard marketing tasks",
+	Long: `Soft-delete a marketing task. It can be restored later with 'ygm tasks restore'.
+
+If this CLI has read the task before, it is only discarded if nobody changed
+it since; use --force to discard it anyway.
+
+Several tasks can be given as IDs or ranges, or selected with --where; they
+are discarded concurrently like 'ygm tasks update' does.
 
 Examples:
   ygm tasks discard 42
-  ygm tasks discard 42 --json`,
-	Args: cobra.ExactArgs(1),
+  ygm tasks discard 42 --json
+  ygm tasks discard 10-15 42
+  ygm tasks discard --where status=pending,platform=reddit`,
 	RunE: runTasksDiscard,
 }
 
+var (
+	discardForce       bool
+	discardWhere       string
+	discardConcurrency int
+)
+
+func init() {
+	tasksDiscardCmd.Flags().BoolVar(&discardForce, "force", false, "Discard even if the task changed since it was last read")
+	addBulkFlags(tasksDiscardCmd, &discardWhere, &discardConcurrency)
+}
+
 func runTasksDiscard(cmd *cobra.Command, args []string) error {
-	id, err := strconv.Atoi(args[0])
+	account, err := getActiveAccount()
 	if err != nil {
-		return fmt.Errorf("invalid task ID: %s", args[0])
+		return err
 	}
 
-	account, err := getActiveAccount()
+	client := api.NewClient(account.APIURL, account.Token)
+	cache := loadTaskCache()
+
+	ids, err := selectTaskIDs(client, args, discardWhere, cache)
 	if err != nil {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
+	if len(ids) == 1 && discardWhere == "" {
+		return discardOneTask(client, cache, ids[0])
+	}
+
+	defer saveTaskCache(cache)
+	return runBulk(ids, discardConcurrency, func(id int) bulkResult {
+		var cond *api.Precondition
+		if !discardForce {
+			cond = cache.Precondition(id)
+		}
+
+		if _, err := client.DiscardTask(id, cond); err != nil {
+			return bulkResult{ID: id, Error: bulkError(err, "--force")}
+		}
+		cache.Forget(id)
+		return bulkResult{ID: id, OK: true}
+	})
+}
+
+// discardOneTask discards a single task
+func discardOneTask(client *api.Client, cache *taskcache.Cache, id int) error {
+	var cond *api.Precondition
+	if !discardForce {
+		cond = cache.Precondition(id)
+	}
 
-	result, err := client.DiscardTask(id)
+	result, err := client.DiscardTask(id, cond)
 	if err != nil {
-		return fmt.Errorf("failed to discard task: %w", err)
+		return fmt.Errorf("failed to discard task: %w",
+			explainConflict(err, api.UpdateTaskRequest{}, cond, "re-run with --force to discard it anyway"))
 	}
+	cache.Forget(id)
+	saveTaskCache(cache)
 
 	if jsonOutput {
 		return outputJSON(result)
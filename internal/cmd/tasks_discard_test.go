@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskcache"
+)
+
+// TestTasksDiscardConflict checks a discard is conditional on the cached
+// version of the task and that a 412 is explained rather than passed through
+func TestTasksDiscardConflict(t *testing.T) {
+	var ifMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = append(ifMatch, r.Header.Get("If-Match"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("If-Match") != "" {
+			w.Header().Set("ETag", `"v2"`)
+			w.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprint(w, `{"task":{"id":42,"title":"Launch (edited)","status":"in_progress","updated_at":"2026-10-18T10:00:00Z"}}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	useTestAccount(t, server.URL)
+	// The config isn't loaded outside a command, so name the org directly
+	cache := taskcache.Load("acme")
+	cache.Remember(api.Task{ID: 42, ETag: `"v1"`, UpdatedAt: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)})
+	saveTaskCache(cache)
+
+	err := executeCommand(t, "tasks", "discard", "42")
+	var conflict *api.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("error = %v, want a conflict", err)
+	}
+	if conflict.Current == nil || conflict.Current.Title != "Launch (edited)" {
+		t.Errorf("conflict.Current = %+v, want the server's version", conflict.Current)
+	}
+	if !strings.Contains(err.Error(), "re-run with --force to discard it anyway") {
+		t.Errorf("error %q doesn't say how to retry", err)
+	}
+
+	if err := executeCommand(t, "tasks", "discard", "42", "--force"); err != nil {
+		t.Fatalf("discard --force: %v", err)
+	}
+	if want := []string{`"v1"`, ""}; strings.Join(ifMatch, "|") != strings.Join(want, "|") {
+		t.Errorf("If-Match headers = %q, want %q", ifMatch, want)
+	}
+}
@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var tasksEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit a task in your editor",
+	Long: `Open a task in $VISUAL or $EDITOR as Markdown with YAML front-matter.
+
+The front-matter holds the title, status, platform, asset type and suggested
+post date. The description and the image, copy and video prompts are
+Markdown sections below it. Empty a field or section to clear it; removing
+a field or a section heading leaves it unchanged.
+
+Only the fields you changed are sent, and only if the task wasn't changed on
+the server while you were editing. Otherwise nothing is saved and your edits
+are kept in a temporary file.
+
+Examples:
+  ygm tasks edit 42
+  EDITOR="code --wait" ygm tasks edit 42`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksEdit,
+}
+
+// taskFrontMatter is the YAML header of an edited task
+type taskFrontMatter struct {
+	Title             string `yaml:"title"`
+	Status            string `yaml:"status"`
+	Platform          string `yaml:"platform"`
+	AssetType         string `yaml:"asset_type"`
+	SuggestedPostDate string `yaml:"suggested_post_date"`
+}
+
+// Body sections of an edited task, in the order they are written
+var taskSections = []string{"Description", "Image Prompt", "Copy Prompt", "Video Prompt"}
+
+func runTasksEdit(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	original, err := client.GetTask(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	content, err := renderTaskMarkdown(original)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", fmt.Sprintf("ygm-task-%d-*.md", id))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, err = tmp.Write(content)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := openEditor(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to read edited task: %w", err)
+	}
+
+	req, err := parseTaskMarkdown(edited, original)
+	if err != nil {
+		return fmt.Errorf("%w (your edits are in %s)", err, tmpPath)
+	}
+	if req.IsEmpty() {
+		os.Remove(tmpPath)
+		fmt.Println("No changes.")
+		return nil
+	}
+
+	// Refuse to overwrite changes made by someone else while we were editing
+	read := api.PreconditionFor(original)
+	task, err := client.UpdateTask(id, req, read)
+	if err != nil {
+		var conflict *api.ConflictError
+		if errors.As(err, &conflict) {
+			explainConflict(err, req, read, "")
+			return fmt.Errorf("%w while you were editing; nothing was saved (your edits are in %s)", conflict, tmpPath)
+		}
+		return fmt.Errorf("failed to update task (your edits are in %s): %w", tmpPath, err)
+	}
+	os.Remove(tmpPath)
+
+	cache := loadTaskCache()
+	cache.Remember(*task)
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		return outputJSON(task)
+	}
+
+	fmt.Printf("Updated task #%d: %s\n", task.ID, task.Title)
+
+	return nil
+}
+
+// renderTaskMarkdown renders a task as Markdown with YAML front-matter
+func renderTaskMarkdown(task *api.Task) ([]byte, error) {
+	fm := taskFrontMatter{
+		Title:     task.Title,
+		Status:    task.Status,
+		Platform:  task.Platform,
+		AssetType: task.AssetType,
+	}
+	if task.SuggestedPostDate != nil {
+		fm.SuggestedPostDate = *task.SuggestedPostDate
+	}
+
+	header, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render task: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(header)
+	buf.WriteString("---\n")
+
+	bodies := taskSectionValues(task)
+	for _, section := range taskSections {
+		fmt.Fprintf(&buf, "\n# %s\n\n", section)
+		if body := bodies[section]; body != "" {
+			buf.WriteString(body)
+			buf.WriteString("\n")
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// taskSectionValues returns the current content of each body section
+func taskSectionValues(task *api.Task) map[string]string {
+	return map[string]string{
+		"Description":  task.Description,
+		"Image Prompt": task.ImagePrompt,
+		"Copy Prompt":  task.CopyPrompt,
+		"Video Prompt": task.VideoPrompt,
+	}
+}
+
+// parseTaskMarkdown parses an edited task and returns an update containing
+// only the fields that differ from original. Empty values clear the field;
+// removed front-matter keys and section headings leave it unchanged.
+func parseTaskMarkdown(data []byte, original *api.Task) (api.UpdateTaskRequest, error) {
+	var req api.UpdateTaskRequest
+
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if !strings.HasPrefix(text, "---\n") {
+		return req, fmt.Errorf("missing front-matter: the file must start with ---")
+	}
+	end := strings.Index(text[4:], "\n---\n")
+	if end < 0 {
+		return req, fmt.Errorf("unterminated front-matter: add a closing --- line")
+	}
+	header, body := text[4:4+end+1], text[4+end+5:]
+
+	var fm taskFrontMatter
+	dec := yaml.NewDecoder(strings.NewReader(header))
+	dec.KnownFields(true)
+	if err := dec.Decode(&fm); err != nil {
+		return req, fmt.Errorf("invalid front-matter: %w", err)
+	}
+	// Decode again to tell a removed key (unchanged) from an emptied one
+	var keys map[string]interface{}
+	if err := yaml.Unmarshal([]byte(header), &keys); err != nil {
+		return req, fmt.Errorf("invalid front-matter: %w", err)
+	}
+
+	sections, err := parseTaskSections(body)
+	if err != nil {
+		return req, err
+	}
+
+	if _, ok := keys["title"]; ok && fm.Title != original.Title {
+		if strings.TrimSpace(fm.Title) == "" {
+			return req, fmt.Errorf("title can't be empty")
+		}
+		req.Title = api.Set(fm.Title)
+	}
+	if _, ok := keys["status"]; ok && fm.Status != original.Status {
+		if fm.Status == "" {
+			return req, fmt.Errorf("status can't be empty")
+		}
+		req.Status = api.Set(fm.Status)
+	}
+	if _, ok := keys["platform"]; ok {
+		req.Platform = changedField(original.Platform, fm.Platform)
+	}
+	if _, ok := keys["asset_type"]; ok {
+		req.AssetType = changedField(original.AssetType, fm.AssetType)
+	}
+	if _, ok := keys["suggested_post_date"]; ok {
+		originalDate := ""
+		if original.SuggestedPostDate != nil {
+			originalDate = *original.SuggestedPostDate
+		}
+		req.SuggestedPostDate = changedField(originalDate, fm.SuggestedPostDate)
+	}
+
+	// Sections whose heading was removed are left unchanged
+	current := taskSectionValues(original)
+	changedSection := func(name string) api.Optional[string] {
+		edited, ok := sections[name]
+		if !ok {
+			return api.Optional[string]{}
+		}
+		return changedField(current[name], edited)
+	}
+	req.Description = changedSection("Description")
+	req.ImagePrompt = changedSection("Image Prompt")
+	req.CopyPrompt = changedSection("Copy Prompt")
+	req.VideoPrompt = changedSection("Video Prompt")
+
+	return req, nil
+}
+
+// parseTaskSections splits the Markdown body into its "# Heading" sections.
+// Only the known section headings start a section; any other heading,
+// including a "# " one, is part of the section content.
+func parseTaskSections(body string) (map[string]string, error) {
+	sections := make(map[string]string)
+	current := ""
+	var lines []string
+
+	flush := func() {
+		if current != "" {
+			sections[current] = strings.TrimSpace(strings.Join(lines, "\n"))
+		}
+		lines = nil
+	}
+
+	for i, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "# ") && slices.Contains(taskSections, strings.TrimSpace(line[2:])) {
+			flush()
+			current = strings.TrimSpace(line[2:])
+			if _, ok := sections[current]; ok {
+				return nil, fmt.Errorf("section '# %s' appears twice (body line %d)", current, i+1)
+			}
+			continue
+		}
+		if current == "" {
+			if strings.TrimSpace(line) != "" {
+				return nil, fmt.Errorf("text before the first section on body line %d (expected a heading: # %s)",
+					i+1, strings.Join(taskSections, ", # "))
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+	flush()
+
+	return sections, nil
+}
+
+// changedField returns an unset Optional if edited matches original, null if
+// the field was emptied, and the new value otherwise
+func changedField(original, edited string) api.Optional[string] {
+	edited = strings.TrimSpace(edited)
+	switch {
+	case edited == strings.TrimSpace(original):
+		return api.Optional[string]{}
+	case edited == "":
+		return api.Null[string]()
+	default:
+		return api.Set(edited)
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+func TestParseTaskSectionsKeepsOtherHeadings(t *testing.T) {
+	body := strings.Join([]string{
+		"",
+		"# Description",
+		"",
+		"# Launch week",
+		"",
+		"Intro paragraph.",
+		"",
+		"## Details",
+		"",
+		"# Copy Prompt",
+		"",
+		"Write a tweet.",
+	}, "\n")
+
+	sections, err := parseTaskSections(body)
+	if err != nil {
+		t.Fatalf("parseTaskSections: %v", err)
+	}
+
+	want := "# Launch week\n\nIntro paragraph.\n\n## Details"
+	if got := sections["Description"]; got != want {
+		t.Errorf("Description =\n%q\nwant\n%q", got, want)
+	}
+	if got := sections["Copy Prompt"]; got != "Write a tweet." {
+		t.Errorf("Copy Prompt = %q", got)
+	}
+}
+
+func TestParseTaskSectionsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"text before the first section", "Hello\n# Description\n", "text before the first section"},
+		{"duplicate section", "# Description\nA\n# Description\nB\n", "appears twice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTaskSections(tt.body)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseTaskMarkdownAbsentFields checks that removing a front-matter key
+// or a section heading leaves the field unchanged, while emptying it clears
+// it
+func TestParseTaskMarkdownAbsentFields(t *testing.T) {
+	date := "2026-11-02"
+	original := &api.Task{
+		ID:                42,
+		Title:             "Launch",
+		Status:            "pending",
+		Platform:          "linkedin",
+		AssetType:         "copy",
+		SuggestedPostDate: &date,
+		Description:       "Announce v2",
+		CopyPrompt:        "Write a post.",
+		VideoPrompt:       "A short demo.",
+	}
+	rendered, err := renderTaskMarkdown(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		edit func(string) string
+		want string
+	}{
+		{
+			name: "unchanged",
+			edit: func(s string) string { return s },
+			want: `{}`,
+		},
+		{
+			name: "platform key removed",
+			edit: func(s string) string { return strings.Replace(s, "platform: linkedin\n", "", 1) },
+			want: `{}`,
+		},
+		{
+			name: "platform emptied",
+			edit: func(s string) string { return strings.Replace(s, "platform: linkedin\n", "platform: \"\"\n", 1) },
+			want: `{"platform":null}`,
+		},
+		{
+			name: "platform left without a value",
+			edit: func(s string) string { return strings.Replace(s, "platform: linkedin\n", "platform:\n", 1) },
+			want: `{"platform":null}`,
+		},
+		{
+			name: "title and status keys removed",
+			edit: func(s string) string {
+				return strings.Replace(strings.Replace(s, "title: Launch\n", "", 1), "status: pending\n", "", 1)
+			},
+			want: `{}`,
+		},
+		{
+			name: "video prompt heading removed",
+			edit: func(s string) string { return strings.Replace(s, "# Video Prompt\n\n", "", 1) },
+			want: `{"copy_prompt":"Write a post.\n\nA short demo."}`,
+		},
+		{
+			name: "video prompt emptied",
+			edit: func(s string) string { return strings.Replace(s, "A short demo.\n", "", 1) },
+			want: `{"video_prompt":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edited := tt.edit(string(rendered))
+			if edited == string(rendered) && tt.name != "unchanged" {
+				t.Fatalf("edit didn't change the file:\n%s", rendered)
+			}
+			req, err := parseTaskMarkdown([]byte(edited), original)
+			if err != nil {
+				t.Fatalf("parseTaskMarkdown: %v", err)
+			}
+			got, err := json.Marshal(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("request = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskio"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormat      string
+	exportColumns     string
+	exportDetailed    bool
+	exportOutput      string
+	exportStatus      string
+	exportPlatform    string
+	exportConcurrency int
+)
+
+var tasksExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tasks as CSV, TSV, Markdown or HTML",
+	Long: `Export tasks as a table for spreadsheets, wikis or documents.
+
+Choose the columns with --columns (default: id, title, status, platform,
+asset_type, suggested_post_date, description). --detailed adds the prompts
+and selected copy, which are fetched task by task. Naming a detailed column
+in --columns fetches them too.
+
+Output goes to stdout unless --output is given. In CSV and TSV, values
+starting with =, +, -, @, a tab or a carriage return are prefixed with ' so
+spreadsheets show them as text instead of running them as formulas.
+
+Available columns: ` + strings.Join(taskio.ColumnNames(), ", ") + `
+
+Examples:
+  ygm tasks export > plan.csv
+  ygm tasks export --format markdown --columns id,title,suggested_post_date
+  ygm tasks export --format html --detailed --output plan.html
+  ygm tasks export --format tsv --status pending | pbcopy`,
+	Args: cobra.NoArgs,
+	RunE: runTasksExport,
+}
+
+func init() {
+	tasksExportCmd.Flags().StringVar(&exportFormat, "format", "csv", "Output format: "+strings.Join(taskio.ExportFormats, ", "))
+	tasksExportCmd.Flags().StringVar(&exportColumns, "columns", "", "Comma-separated columns to export, in order")
+	tasksExportCmd.Flags().BoolVar(&exportDetailed, "detailed", false, "Include prompts and selected copy (fetches each task)")
+	tasksExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to a file instead of stdout")
+	tasksExportCmd.Flags().StringVar(&exportStatus, "status", "", "Filter by status (pending, in_progress, completed)")
+	tasksExportCmd.Flags().StringVar(&exportPlatform, "platform", "", "Filter by platform (instagram, twitter, linkedin, etc.)")
+	tasksExportCmd.Flags().IntVar(&exportConcurrency, "concurrency", defaultBulkConcurrency, fmt.Sprintf("Number of tasks to fetch at once with --detailed (1-%d)", maxBulkConcurrency))
+}
+
+func runTasksExport(cmd *cobra.Command, args []string) error {
+	// Check the format before fetching anything or truncating --output
+	if !slices.Contains(taskio.ExportFormats, exportFormat) {
+		return fmt.Errorf("unknown format '%s' (expected one of: %s)", exportFormat, strings.Join(taskio.ExportFormats, ", "))
+	}
+
+	var names []string
+	if exportColumns != "" {
+		names = strings.Split(exportColumns, ",")
+	}
+	cols, err := taskio.SelectColumns(names, exportDetailed)
+	if err != nil {
+		return err
+	}
+	if exportConcurrency < 1 || exportConcurrency > maxBulkConcurrency {
+		return fmt.Errorf("--concurrency must be between 1 and %d", maxBulkConcurrency)
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	tasks, err := client.GetTasks(api.TaskFilter{Status: exportStatus, Platform: exportPlatform})
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	if exportDetailed || taskio.NeedsDetails(cols) {
+		if tasks, err = fetchTaskDetails(client, tasks, exportConcurrency); err != nil {
+			return err
+		}
+	}
+
+	if exportOutput == "" {
+		if err := taskio.Export(os.Stdout, exportFormat, tasks, cols); err != nil {
+			return fmt.Errorf("failed to export tasks: %w", err)
+		}
+		return nil
+	}
+
+	f, err := os.Create(exportOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", exportOutput, err)
+	}
+	if err := taskio.Export(f, exportFormat, tasks, cols); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to export tasks: %w", err)
+	}
+	// A failed close can mean the data never reached the disk
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", exportOutput, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d tasks to %s\n", len(tasks), exportOutput)
+	return nil
+}
+
+// fetchTaskDetails re-fetches every task individually to get its detailed
+// fields, using up to concurrency requests at once. The order is kept.
+func fetchTaskDetails(client *api.Client, tasks []api.Task, concurrency int) ([]api.Task, error) {
+	detailed := make([]api.Task, len(tasks))
+	errs := make([]error, len(tasks))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(tasks); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				task, err := client.GetTask(tasks[i].ID)
+				if err != nil {
+					errs[i] = fmt.Errorf("failed to fetch task #%d: %w", tasks[i].ID, err)
+					continue
+				}
+				detailed[i] = *task
+			}
+		}()
+	}
+	for i := range tasks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return detailed, nil
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestTasksExportUnknownFormat checks an invalid --format fails before any
+// request is made or --output is truncated
+func TestTasksExportUnknownFormat(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	useTestAccount(t, server.URL)
+	if err := os.WriteFile("tasks.csv", []byte("id,title\n1,Launch\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := executeCommand(t, "tasks", "export", "--format", "pdf", "--detailed", "-o", "tasks.csv")
+	if err == nil || !strings.Contains(err.Error(), "unknown format 'pdf'") {
+		t.Fatalf("error = %v, want an unknown format error", err)
+	}
+	if requests != 0 {
+		t.Errorf("%d requests made, want none", requests)
+	}
+	data, err := os.ReadFile("tasks.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "id,title\n1,Launch\n" {
+		t.Errorf("tasks.csv was modified: %q", data)
+	}
+}
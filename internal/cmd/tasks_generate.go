@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksGenerateCmd = &cobra.Command{
+	Use:   "generate <id> --via <command>",
+	Short: "Generate copy for a task with a local tool",
+	Long: `Generate copy for a task by piping its prompt into a command, such as an
+LLM CLI or a script, and save the command's output as the task's selected
+copy.
+
+The command is run by the shell with the prompt (as 'ygm tasks prompt'
+prints it) on stdin; whatever it writes to stdout is the result. The result
+is shown for approval before it's saved.
+
+With --variants, the command is run several times and you pick one of the
+candidates. Use --yes to save a single result without asking, e.g. in
+scripts.
+
+Examples:
+  ygm tasks generate 42 --via "llm -m gpt-4o"
+  ygm tasks generate 42 --via "ollama run llama3" --with-brand --variants 3
+  ygm tasks generate 42 --via "python3 write_copy.py" --yes --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksGenerate,
+}
+
+var (
+	generateKind      string
+	generateVia       string
+	generateWithBrand bool
+	generateVariants  int
+	generateYes       bool
+)
+
+// maxGenerateVariants limits how many times --variants runs the command
+const maxGenerateVariants = 10
+
+func init() {
+	tasksGenerateCmd.Flags().StringVar(&generateKind, "kind", "copy", "Prompt to generate from (only copy can be saved)")
+	tasksGenerateCmd.Flags().StringVar(&generateVia, "via", "", "Command to run; the prompt is written to its stdin (required)")
+	tasksGenerateCmd.Flags().BoolVar(&generateWithBrand, "with-brand", false, "Include brand voice, palette and fonts in the prompt")
+	tasksGenerateCmd.Flags().IntVar(&generateVariants, "variants", 1, fmt.Sprintf("Number of candidates to generate and choose from (1-%d)", maxGenerateVariants))
+	tasksGenerateCmd.Flags().BoolVarP(&generateYes, "yes", "y", false, "Save the result without asking")
+	tasksGenerateCmd.MarkFlagRequired("via")
+}
+
+func runTasksGenerate(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	if generateKind != "copy" {
+		if slices.Contains(promptKinds, generateKind) {
+			return fmt.Errorf("only copy can be saved to a task; use 'ygm tasks prompt %d --kind %s' to pipe %s prompts elsewhere", id, generateKind, generateKind)
+		}
+		return fmt.Errorf("invalid --kind %q (expected copy)", generateKind)
+	}
+	if generateVariants < 1 || generateVariants > maxGenerateVariants {
+		return fmt.Errorf("--variants must be between 1 and %d", maxGenerateVariants)
+	}
+	if generateYes && generateVariants > 1 {
+		return fmt.Errorf("--yes can't pick between variants; drop --variants or choose interactively")
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	task, err := client.GetTask(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	prompt, guidelines, err := composePrompt(client, task, generateKind, generateWithBrand)
+	if err != nil {
+		return err
+	}
+	input := plainPrompt(prompt, guidelines) + "\n"
+
+	// Show progress and candidates on stderr with --json, keeping stdout
+	// for the result
+	var display io.Writer = os.Stdout
+	if jsonOutput {
+		display = os.Stderr
+	}
+
+	var variants []string
+	for i := 1; i <= generateVariants; i++ {
+		if generateVariants > 1 {
+			fmt.Fprintf(os.Stderr, "Generating variant %d of %d…\n", i, generateVariants)
+		}
+		output, err := runGenerator(generateVia, input)
+		if err != nil {
+			return err
+		}
+		if output == "" {
+			return fmt.Errorf("%s produced no output", generateVia)
+		}
+		variants = append(variants, output)
+	}
+
+	for i, v := range variants {
+		if len(variants) > 1 {
+			fmt.Fprintf(display, "\n── Variant %d ──\n", i+1)
+		} else {
+			fmt.Fprintf(display, "\n── Generated copy for task #%d ──\n", task.ID)
+		}
+		fmt.Fprintln(display, v)
+	}
+	fmt.Fprintln(display)
+
+	choice := 0
+	if !generateYes {
+		choice, err = pickVariant(task, len(variants))
+		if err != nil {
+			return err
+		}
+		if choice < 0 {
+			fmt.Fprintln(os.Stderr, "Nothing saved.")
+			return nil
+		}
+	}
+
+	updated, err := client.SetCopy(task.ID, variants[choice])
+	if err != nil {
+		return fmt.Errorf("failed to save copy: %w", err)
+	}
+
+	rememberTask(updated)
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"task":     updated,
+			"variants": variants,
+			"selected": choice + 1,
+		})
+	}
+
+	fmt.Printf("Saved as the selected copy for task #%d: %s\n", updated.ID, updated.Title)
+	return nil
+}
+
+// runGenerator runs command through the shell with input on stdin and
+// returns its output. The command's stderr goes to ours so errors and
+// progress stay visible.
+func runGenerator(command, input string) (string, error) {
+	var c *exec.Cmd
+	if runtime.GOOS == "windows" {
+		c = exec.Command("cmd", "/C", command)
+	} else {
+		c = exec.Command("sh", "-c", command)
+	}
+	var stdout bytes.Buffer
+	c.Stdin = strings.NewReader(input)
+	c.Stdout = &stdout
+	c.Stderr = os.Stderr
+
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("%s failed: %w", command, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// pickVariant asks which of n candidates to save. It returns the chosen
+// index, or -1 if the user declined.
+func pickVariant(task *api.Task, n int) (int, error) {
+	action := "Save it as the selected copy"
+	if task.SelectedCopy != nil {
+		action = "Replace the current selected copy"
+	}
+
+	if n == 1 {
+		ok, err := confirm(action + "?")
+		if err != nil || !ok {
+			return -1, err
+		}
+		return 0, nil
+	}
+
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return -1, fmt.Errorf("picking a variant needs a terminal; run without --variants and use --yes in scripts")
+	}
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Fprintf(os.Stderr, "%s with which variant? [1-%d, Enter to skip] ", action, n)
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			return -1, nil
+		}
+		if choice, convErr := strconv.Atoi(answer); convErr == nil && choice >= 1 && choice <= n {
+			return choice - 1, nil
+		}
+		if err != nil {
+			return -1, nil
+		}
+		fmt.Fprintf(os.Stderr, "Please enter a number from 1 to %d.\n", n)
+	}
+}
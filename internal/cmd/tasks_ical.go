@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskio"
+	"github.com/spf13/cobra"
+)
+
+var (
+	icalOutput   string
+	icalServe    string
+	icalStatus   string
+	icalPlatform string
+)
+
+var tasksICalCmd = &cobra.Command{
+	Use:   "ical",
+	Short: "Export scheduled tasks as an iCalendar (.ics) file",
+	Long: `Export tasks that have a suggested post date as all-day calendar events,
+for Google Calendar, Outlook or Apple Calendar.
+
+Each event's UID is derived from the task ID and organization, so
+re-importing or refreshing updates events instead of duplicating them.
+Pending tasks are tentative events; tasks in progress or completed are
+confirmed. The platform is the event category.
+
+With --serve, a local feed is served instead. It is regenerated from the API
+on every request, so a calendar app subscribed to it stays up to date. The
+feed is only at /tasks.ics. An address without a host, like :8088, listens
+on 127.0.0.1 only; give a host such as 0.0.0.0:8088 to share it with other
+machines, which lets anyone who can reach it read your tasks.
+
+Examples:
+  ygm tasks ical > tasks.ics
+  ygm tasks ical --status pending --output pending.ics
+  ygm tasks ical --serve :8088   # subscribe to http://127.0.0.1:8088/tasks.ics`,
+	Args: cobra.NoArgs,
+	RunE: runTasksICal,
+}
+
+func init() {
+	tasksICalCmd.Flags().StringVarP(&icalOutput, "output", "o", "", "Write to a file instead of stdout")
+	tasksICalCmd.Flags().StringVar(&icalServe, "serve", "", "Serve a live feed on this address (e.g. :8088, which listens on 127.0.0.1)")
+	tasksICalCmd.Flags().StringVar(&icalStatus, "status", "", "Filter by status (pending, in_progress, completed)")
+	tasksICalCmd.Flags().StringVar(&icalPlatform, "platform", "", "Filter by platform (instagram, twitter, linkedin, etc.)")
+	tasksICalCmd.MarkFlagsMutuallyExclusive("output", "serve")
+}
+
+func runTasksICal(cmd *cobra.Command, args []string) error {
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	org, _ := resolveOrg()
+	opts := taskio.ICalOptions{Org: org, Version: Version}
+
+	if icalServe != "" {
+		return serveICal(client, opts)
+	}
+
+	if icalOutput == "" {
+		return writeICal(os.Stdout, client, opts)
+	}
+
+	f, err := os.Create(icalOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", icalOutput, err)
+	}
+	if err := writeICal(f, client, opts); err != nil {
+		f.Close()
+		return err
+	}
+	// A failed close can mean the data never reached the disk
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", icalOutput, err)
+	}
+	return nil
+}
+
+func writeICal(w io.Writer, client *api.Client, opts taskio.ICalOptions) error {
+	tasks, err := client.GetTasks(api.TaskFilter{Status: icalStatus, Platform: icalPlatform})
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+	return taskio.WriteICal(w, tasks, opts)
+}
+
+// serveICal serves the calendar over HTTP until interrupted
+func serveICal(client *api.Client, opts taskio.ICalOptions) error {
+	addr, loopback, err := listenAddr(icalServe)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tasks.ics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Render fully before writing so an API failure isn't served as a
+		// truncated calendar
+		var buf bytes.Buffer
+		if err := writeICal(&buf, client, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", time.Now().Format("15:04:05"), r.URL.Path, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="tasks.ics"`)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write(buf.Bytes())
+		fmt.Fprintf(os.Stderr, "%s %s: served %d bytes\n", time.Now().Format("15:04:05"), r.URL.Path, buf.Len())
+	})
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	// Show the host as given, with the port actually used in case it was 0
+	host, _, _ := net.SplitHostPort(addr)
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	addr = net.JoinHostPort(host, port)
+
+	if !loopback {
+		fmt.Fprintf(os.Stderr, "Warning: %s is reachable from other machines; anyone who can connect can read your tasks\n", addr)
+	}
+	fmt.Fprintf(os.Stderr, "Serving %s tasks as iCalendar on http://%s/tasks.ics (Ctrl+C to stop)\n", opts.Org, addr)
+	return server.Serve(ln)
+}
+
+// listenAddr resolves a --serve address, defaulting to 127.0.0.1 when it
+// has no host, and reports whether it only accepts local connections
+func listenAddr(addr string) (string, bool, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid --serve address '%s' (expected [host]:port, e.g. :8088)", addr)
+	}
+	if host == "" {
+		host = "127.0.0.1"
+	}
+
+	loopback := host == "localhost"
+	if ip := net.ParseIP(host); ip != nil {
+		loopback = ip.IsLoopback()
+	}
+	return net.JoinHostPort(host, port), loopback, nil
+}
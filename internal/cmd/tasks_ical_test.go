@@ -0,0 +1,35 @@
+package cmd
+
+import "testing"
+
+func TestListenAddr(t *testing.T) {
+	tests := []struct {
+		addr         string
+		want         string
+		wantLoopback bool
+	}{
+		{":8088", "127.0.0.1:8088", true},
+		{"localhost:8088", "localhost:8088", true},
+		{"127.0.0.1:8088", "127.0.0.1:8088", true},
+		{"[::1]:8088", "[::1]:8088", true},
+		{"0.0.0.0:8088", "0.0.0.0:8088", false},
+		{"[::]:8088", "[::]:8088", false},
+		{"192.168.1.20:8088", "192.168.1.20:8088", false},
+		{"calendar.local:8088", "calendar.local:8088", false},
+	}
+
+	for _, tt := range tests {
+		got, loopback, err := listenAddr(tt.addr)
+		if err != nil {
+			t.Errorf("listenAddr(%q): %v", tt.addr, err)
+			continue
+		}
+		if got != tt.want || loopback != tt.wantLoopback {
+			t.Errorf("listenAddr(%q) = %q, %v, want %q, %v", tt.addr, got, loopback, tt.want, tt.wantLoopback)
+		}
+	}
+
+	if _, _, err := listenAddr("8088"); err == nil {
+		t.Error("listenAddr(\"8088\") succeeded, want an error")
+	}
+}
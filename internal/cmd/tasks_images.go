@@ -0,0 +1,413 @@
+package cmd
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var tasksImagesCmd = &cobra.Command{
+	Use:   "images <id> [--download <dir> | --upload <file>...]",
+	Short: "List, download or upload a task's images",
+	Long: `Work with the images attached to a task.
+
+Without flags, lists the images. --download fetches them into a directory,
+several at a time, and checks each file against the checksum the API
+reports; files that are already there and unchanged are skipped. --upload
+attaches image files to the task.
+
+Uploads must be PNG, JPEG, GIF or WebP images of at most 20 MB. The type is
+detected from the file's contents, not its name, and every file is checked
+before anything is sent.
+
+Examples:
+  ygm tasks images 42
+  ygm tasks images 42 --download assets/
+  ygm tasks images 42 --upload hero.png banner.jpg`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTasksImages,
+}
+
+var (
+	imagesDownload    string
+	imagesUpload      bool
+	imagesConcurrency int
+)
+
+// maxImageSize is the largest image accepted for upload
+const maxImageSize = 20 << 20
+
+// imageTypes are the content types accepted for upload
+var imageTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+func init() {
+	tasksImagesCmd.Flags().StringVar(&imagesDownload, "download", "", "Download the images into this directory")
+	tasksImagesCmd.Flags().BoolVar(&imagesUpload, "upload", false, "Upload the files given after the task ID")
+	tasksImagesCmd.Flags().IntVar(&imagesConcurrency, "concurrency", defaultBulkConcurrency, fmt.Sprintf("Number of images to download at once (1-%d)", maxBulkConcurrency))
+	tasksImagesCmd.MarkFlagsMutuallyExclusive("download", "upload")
+}
+
+func runTasksImages(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	files := args[1:]
+	if imagesUpload && len(files) == 0 {
+		return fmt.Errorf("--upload needs at least one file")
+	}
+	if !imagesUpload && len(files) > 0 {
+		return fmt.Errorf("files can only be given with --upload")
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	if imagesUpload {
+		return uploadImages(client, id, files)
+	}
+
+	images, err := client.ListImages(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch images: %w", err)
+	}
+
+	if imagesDownload != "" {
+		return downloadImages(client, images, imagesDownload)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"task_id": id, "images": images})
+	}
+
+	if len(images) == 0 {
+		fmt.Printf("No images for task #%d.\n", id)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  ID\tFILE\tTYPE\tSIZE")
+	for _, img := range images {
+		marker := " "
+		if img.Selected {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%s %d\t%s\t%s\t%s\n", marker, img.ID, img.Filename, img.ContentType, formatBytes(img.ByteSize))
+	}
+	return w.Flush()
+}
+
+// imageDownload is the outcome of downloading one image
+type imageDownload struct {
+	ID     int    `json:"id"`
+	Path   string `json:"path"`
+	Status string `json:"status"` // downloaded, unchanged or failed
+	Error  string `json:"error,omitempty"`
+}
+
+func downloadImages(client *api.Client, images []api.TaskImage, dir string) error {
+	if imagesConcurrency < 1 || imagesConcurrency > maxBulkConcurrency {
+		return fmt.Errorf("--concurrency must be between 1 and %d", maxBulkConcurrency)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	paths := imagePaths(images, dir)
+	results := make([]imageDownload, len(images))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < imagesConcurrency && i < len(images); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = downloadImage(client, images[i], paths[i])
+			}
+		}()
+	}
+	for i := range images {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := []taskFailure{}
+	for _, r := range results {
+		if r.Status == "failed" {
+			failed = append(failed, taskFailure{ID: r.ID, Error: r.Error})
+		}
+	}
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"images": results}); err != nil {
+			return err
+		}
+	} else {
+		if len(images) == 0 {
+			fmt.Println("No images to download.")
+		}
+		for _, r := range results {
+			if r.Error != "" {
+				fmt.Printf("  %-10s %s: %s\n", r.Status, r.Path, r.Error)
+			} else {
+				fmt.Printf("  %-10s %s\n", r.Status, r.Path)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d images failed to download", len(failed), len(images))
+	}
+	return nil
+}
+
+// imagePaths picks a file name in dir for each image, prefixing the image
+// ID when several images share a name
+func imagePaths(images []api.TaskImage, dir string) []string {
+	counts := make(map[string]int)
+	names := make([]string, len(images))
+	for i, img := range images {
+		name := filepath.Base(filepath.Clean("/" + img.Filename))
+		if name == "/" || name == "." {
+			name = fmt.Sprintf("image-%d", img.ID)
+		}
+		names[i] = name
+		counts[name]++
+	}
+
+	paths := make([]string, len(images))
+	for i, name := range names {
+		if counts[name] > 1 {
+			name = fmt.Sprintf("%d-%s", images[i].ID, name)
+		}
+		paths[i] = filepath.Join(dir, name)
+	}
+	return paths
+}
+
+// downloadImage fetches one image to path, via a temporary file that only
+// replaces path once the checksum matches
+func downloadImage(client *api.Client, img api.TaskImage, path string) imageDownload {
+	result := imageDownload{ID: img.ID, Path: path, Status: "failed"}
+
+	if img.Checksum != "" {
+		if sum, err := fileChecksum(path); err == nil && sum == img.Checksum {
+			result.Status = "unchanged"
+			return result
+		}
+	}
+
+	body, err := client.DownloadImage(img)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer body.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".ygm-download-*")
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), body)
+	if err == nil {
+		err = tmp.Chmod(0644) // Temporary files are private
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if sum := base64.StdEncoding.EncodeToString(hash.Sum(nil)); img.Checksum != "" && sum != img.Checksum {
+		result.Error = fmt.Sprintf("checksum mismatch (expected %s, got %s)", img.Checksum, sum)
+		return result
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = "downloaded"
+	return result
+}
+
+// fileChecksum returns the base64-encoded MD5 of a file, as the API reports
+// image checksums
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// imageUpload is a file checked and ready to upload
+type imageUpload struct {
+	Path        string
+	Size        int64
+	ContentType string
+}
+
+func uploadImages(client *api.Client, id int, files []string) error {
+	// Check every file first so a bad one doesn't leave a partial upload
+	var uploads []imageUpload
+	var problems []string
+	for _, path := range files {
+		upload, err := checkImage(path)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("  %s: %v", path, err))
+			continue
+		}
+		uploads = append(uploads, upload)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("not uploading anything:\n%s", strings.Join(problems, "\n"))
+	}
+
+	uploaded := []api.TaskImage{}
+	for _, upload := range uploads {
+		image, err := uploadImage(client, id, upload)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", upload.Path, err)
+		}
+		uploaded = append(uploaded, *image)
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"task_id": id, "uploaded": uploaded})
+	}
+	fmt.Printf("Uploaded %d images to task #%d\n", len(uploaded), id)
+	return nil
+}
+
+// checkImage validates a file for upload, detecting its type from its
+// contents
+func checkImage(path string) (imageUpload, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return imageUpload{}, err
+	}
+	if !info.Mode().IsRegular() {
+		return imageUpload{}, errors.New("not a regular file")
+	}
+	if info.Size() == 0 {
+		return imageUpload{}, errors.New("file is empty")
+	}
+	if info.Size() > maxImageSize {
+		return imageUpload{}, fmt.Errorf("file is %s; the limit is %s", formatBytes(info.Size()), formatBytes(maxImageSize))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return imageUpload{}, err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return imageUpload{}, err
+	}
+	contentType := http.DetectContentType(head[:n])
+	if !slices.Contains(imageTypes, contentType) {
+		return imageUpload{}, fmt.Errorf("unsupported file type %s (expected PNG, JPEG, GIF or WebP)", contentType)
+	}
+
+	return imageUpload{Path: path, Size: info.Size(), ContentType: contentType}, nil
+}
+
+func uploadImage(client *api.Client, id int, upload imageUpload) (*api.TaskImage, error) {
+	f, err := os.Open(upload.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	progress := &progressReader{
+		r:     f,
+		total: upload.Size,
+		label: filepath.Base(upload.Path),
+		live:  term.IsTerminal(int(os.Stderr.Fd())),
+	}
+	image, err := client.UploadImage(id, filepath.Base(upload.Path), upload.ContentType, progress, upload.Size)
+	progress.finish(err == nil)
+	return image, err
+}
+
+// progressReader reports upload progress on stderr. On a terminal the line
+// is redrawn as bytes are read; otherwise only the result is printed.
+type progressReader struct {
+	r       io.Reader
+	total   int64
+	read    int64
+	label   string
+	live    bool
+	percent int
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.live && p.total > 0 {
+		if percent := int(p.read * 100 / p.total); percent != p.percent {
+			p.percent = percent
+			fmt.Fprintf(os.Stderr, "\rUploading %s %3d%% (%s / %s)", p.label, percent, formatBytes(p.read), formatBytes(p.total))
+		}
+	}
+	return n, err
+}
+
+func (p *progressReader) finish(ok bool) {
+	if p.live {
+		fmt.Fprint(os.Stderr, "\r\x1b[K")
+	}
+	if ok {
+		fmt.Fprintf(os.Stderr, "Uploaded %s (%s)\n", p.label, formatBytes(p.total))
+	}
+}
+
+// formatBytes formats a size like 1.5 MB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGT"[exp])
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskio"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importFormat  string
+	importMapping []string
+	importDryRun  bool
+)
+
+var tasksImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create tasks from a CSV, JSON or YAML file",
+	Long: `Create marketing tasks from a CSV, JSON or YAML file.
+
+CSV files need a header row. Columns named after a task field (title,
+description, platform, asset_type, suggested_post_date; "date" also works)
+are used as-is, other columns are ignored. Use --map to use other columns,
+e.g. --map "Post Title=title" --map "Channel=platform".
+
+JSON files hold an array of task objects and YAML files a list of task
+mappings, both using the field names above.
+
+Every row is validated before anything is created; if any row is invalid,
+the problems are listed with their line numbers and nothing is created.
+Rows with the same title and date as an existing task (or an earlier row)
+are skipped. Empty platform and asset_type fall back to tasks.defaults in
+.ygm.yml.
+
+Examples:
+  ygm tasks import calendar.csv --dry-run
+  ygm tasks import calendar.csv --map "Post Title=title" --map "Channel=platform"
+  ygm tasks import tasks.yml
+  ygm tasks import export.txt --format json --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksImport,
+}
+
+func init() {
+	tasksImportCmd.Flags().StringVar(&importFormat, "format", "", "File format: csv, json or yaml (default: from the file extension)")
+	tasksImportCmd.Flags().StringArrayVar(&importMapping, "map", nil, "Map a CSV column to a task field, as 'Column=field' (repeatable)")
+	tasksImportCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be created without creating anything")
+}
+
+// importResult is the outcome for one row of an import
+type importResult struct {
+	Line   int    `json:"line"`
+	Action string `json:"action"` // "create", "created", "skip" or "failed"
+	Title  string `json:"title"`
+	TaskID int    `json:"task_id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func runTasksImport(cmd *cobra.Command, args []string) error {
+	path := args[0]
+
+	format := importFormat
+	if format == "" {
+		var err error
+		if format, err = taskio.DetectFormat(path); err != nil {
+			return err
+		}
+	}
+
+	mapping := make(map[string]string, len(importMapping))
+	for _, m := range importMapping {
+		column, field, ok := strings.Cut(m, "=")
+		if !ok || strings.TrimSpace(column) == "" {
+			return fmt.Errorf("invalid --map '%s' (expected 'Column=field')", m)
+		}
+		mapping[strings.TrimSpace(column)] = strings.TrimSpace(field)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	rows, err := taskio.Read(path, data, format, mapping)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		fmt.Println("No tasks found in", path)
+		return nil
+	}
+
+	// Fall back to the project's defaults from .ygm.yml, like tasks create
+	if localCfg != nil {
+		for i := range rows {
+			if rows[i].Task.Platform == "" {
+				rows[i].Task.Platform = localCfg.Tasks.Defaults.Platform
+			}
+			if rows[i].Task.AssetType == "" {
+				rows[i].Task.AssetType = localCfg.Tasks.Defaults.AssetType
+			}
+		}
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	existing, err := client.GetTasks(api.TaskFilter{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing tasks: %w", err)
+	}
+
+	// Skip rows matching an existing task or an earlier row
+	seen := make(map[string]string)
+	for _, t := range existing {
+		seen[duplicateKey(t.Title, t.SuggestedPostDate)] = fmt.Sprintf("duplicate of task #%d", t.ID)
+	}
+
+	results := make([]importResult, 0, len(rows))
+	failed := 0
+	for _, row := range rows {
+		result := importResult{Line: row.Line, Title: row.Task.Title}
+		key := duplicateKey(row.Task.Title, row.Task.SuggestedPostDate)
+
+		if reason, dup := seen[key]; dup {
+			result.Action = "skip"
+			result.Reason = reason
+		} else if importDryRun {
+			result.Action = "create"
+			seen[key] = fmt.Sprintf("duplicate of line %d", row.Line)
+		} else {
+			task, err := client.CreateTask(row.Task)
+			if err != nil {
+				result.Action = "failed"
+				result.Reason = err.Error()
+				failed++
+			} else {
+				result.Action = "created"
+				result.TaskID = task.ID
+				seen[key] = fmt.Sprintf("duplicate of task #%d", task.ID)
+			}
+		}
+		results = append(results, result)
+	}
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"dry_run": importDryRun, "results": results}); err != nil {
+			return err
+		}
+	} else {
+		outputImportResults(results)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tasks failed to import", failed, len(rows))
+	}
+	return nil
+}
+
+// duplicateKey identifies a task by title and date when skipping duplicates
+func duplicateKey(title string, date *string) string {
+	key := strings.ToLower(strings.TrimSpace(title))
+	if date != nil {
+		key += "\x00" + *date
+	}
+	return key
+}
+
+func outputImportResults(results []importResult) {
+	counts := make(map[string]int)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tACTION\tTASK\tTITLE\tDETAILS")
+	for _, r := range results {
+		counts[r.Action]++
+		id := ""
+		if r.TaskID != 0 {
+			id = fmt.Sprintf("#%d", r.TaskID)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", r.Line, r.Action, id, clip(r.Title, 50), r.Reason)
+	}
+	w.Flush()
+	fmt.Println()
+
+	if importDryRun {
+		fmt.Printf("Dry run: %d to create, %d to skip. Nothing was created.\n", counts["create"], counts["skip"])
+		return
+	}
+	fmt.Printf("%d created, %d skipped, %d failed.\n", counts["created"], counts["skip"], counts["failed"])
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksMoveCmd = &cobra.Command{
+	Use:   "move <id> --before <id> | --after <id> | --top | --bottom | --position <n>",
+	Short: "Change a task's position",
+	Long: `Move a task up or down within its status group (Pending, In progress or
+Done). This is the same priority order the web app shows, and the order
+'ygm tasks' lists tasks in.
+
+--before and --after take a task in the same status group. Positions start
+at 1.
+
+Examples:
+  ygm tasks move 42 --top
+  ygm tasks move 42 --before 17
+  ygm tasks move 42 --after 17
+  ygm tasks move 42 --position 3 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksMove,
+}
+
+var (
+	moveBefore   int
+	moveAfter    int
+	moveTop      bool
+	moveBottom   bool
+	movePosition int
+)
+
+func init() {
+	tasksMoveCmd.Flags().IntVar(&moveBefore, "before", 0, "Move just before this task")
+	tasksMoveCmd.Flags().IntVar(&moveAfter, "after", 0, "Move just after this task")
+	tasksMoveCmd.Flags().BoolVar(&moveTop, "top", false, "Move to the top of the status group")
+	tasksMoveCmd.Flags().BoolVar(&moveBottom, "bottom", false, "Move to the bottom of the status group")
+	tasksMoveCmd.Flags().IntVar(&movePosition, "position", 0, "Move to this position (1 is the top)")
+	tasksMoveCmd.MarkFlagsMutuallyExclusive("before", "after", "top", "bottom", "position")
+	tasksMoveCmd.MarkFlagsOneRequired("before", "after", "top", "bottom", "position")
+}
+
+func runTasksMove(cmd *cobra.Command, args []string) error {
+	ids, err := parseTaskIDs(args)
+	if err != nil {
+		return err
+	}
+	if len(ids) != 1 {
+		return fmt.Errorf("move takes a single task ID")
+	}
+	id := ids[0]
+
+	if cmd.Flags().Changed("position") && movePosition < 1 {
+		return fmt.Errorf("--position must be 1 or more")
+	}
+	if (moveBefore != 0 && moveBefore == id) || (moveAfter != 0 && moveAfter == id) {
+		return fmt.Errorf("cannot move task #%d relative to itself", id)
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	position := movePosition
+	if !cmd.Flags().Changed("position") {
+		position, err = targetPosition(client, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	task, err := client.MoveTask(id, position)
+	if err != nil {
+		return fmt.Errorf("failed to move task: %w", err)
+	}
+
+	cache := loadTaskCache()
+	cache.Remember(*task)
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		return outputJSON(task)
+	}
+
+	fmt.Printf("Moved task #%d to position %d in %s: %s\n", task.ID, task.Position, statusLabel(task), task.Title)
+	return nil
+}
+
+// targetPosition works out the position for --before, --after, --top and
+// --bottom from the current order of the task's status group
+func targetPosition(client *api.Client, id int) (int, error) {
+	tasks, err := client.GetTasks(api.TaskFilter{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	var task *api.Task
+	for i := range tasks {
+		if tasks[i].ID == id {
+			task = &tasks[i]
+			break
+		}
+	}
+	if task == nil {
+		return 0, fmt.Errorf("task #%d not found", id)
+	}
+	group := statusLabel(task)
+
+	// The order of the group without the task being moved
+	sortByPosition(tasks)
+	var others []api.Task
+	for _, t := range tasks {
+		if t.ID != id && statusLabel(&t) == group {
+			others = append(others, t)
+		}
+	}
+
+	switch {
+	case moveTop:
+		return 1, nil
+	case moveBottom:
+		return len(others) + 1, nil
+	}
+
+	ref := moveBefore
+	if ref == 0 {
+		ref = moveAfter
+	}
+	for i, t := range others {
+		if t.ID != ref {
+			continue
+		}
+		if moveAfter != 0 {
+			return i + 2, nil
+		}
+		return i + 1, nil
+	}
+
+	for _, t := range tasks {
+		if t.ID == ref {
+			return 0, fmt.Errorf("task #%d is in %s, not %s", ref, statusLabel(&t), group)
+		}
+	}
+	return 0, fmt.Errorf("task #%d not found", ref)
+}
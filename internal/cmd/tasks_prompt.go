@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksPromptCmd = &cobra.Command{
+	Use:   "prompt <id>",
+	Short: "Print a task's image, copy or video prompt",
+	Long: `Print a task's generation prompt, ready to pipe into an image generator or
+LLM CLI.
+
+--kind picks the prompt (image, copy or video). Without it, the task's asset
+type decides: image and video tasks use those prompts, everything else the
+copy prompt.
+
+With --with-brand, the brand voice, palette and fonts (plus voice addenda
+from .ygm.yml) are added so the prompt stands on its own.
+
+Formats:
+  plain      The prompt as text (default)
+  markdown   The prompt under headings, for docs and chat tools
+  messages   OpenAI-style chat JSON: {"messages": [system, user]}
+  anthropic  Anthropic Messages API JSON: {"system": ..., "messages": [user]}
+
+In the JSON formats the brand guidelines go in the system prompt.
+
+Examples:
+  ygm tasks prompt 42 --kind copy
+  ygm tasks prompt 42 --kind image --with-brand | some-image-cli
+  ygm tasks prompt 42 --with-brand --format markdown
+  ygm tasks prompt 42 --with-brand --format anthropic | jq '. + {model: "...", max_tokens: 1024}'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTasksPrompt,
+}
+
+var (
+	promptKind      string
+	promptWithBrand bool
+	promptFormat    string
+)
+
+// Prompt kinds and output formats
+var (
+	promptKinds   = []string{"image", "copy", "video"}
+	promptFormats = []string{"plain", "markdown", "messages", "anthropic"}
+)
+
+func init() {
+	tasksPromptCmd.Flags().StringVar(&promptKind, "kind", "", "Prompt to print: "+strings.Join(promptKinds, ", ")+" (default from the asset type)")
+	tasksPromptCmd.Flags().BoolVar(&promptWithBrand, "with-brand", false, "Include brand voice, palette and fonts")
+	tasksPromptCmd.Flags().StringVar(&promptFormat, "format", "plain", "Output format: "+strings.Join(promptFormats, ", "))
+}
+
+// chatMessage is a message in OpenAI and Anthropic chat requests
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+func runTasksPrompt(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	if promptKind != "" && !slices.Contains(promptKinds, promptKind) {
+		return fmt.Errorf("invalid --kind %q (expected one of: %s)", promptKind, strings.Join(promptKinds, ", "))
+	}
+	if !slices.Contains(promptFormats, promptFormat) {
+		return fmt.Errorf("invalid --format %q (expected one of: %s)", promptFormat, strings.Join(promptFormats, ", "))
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	task, err := client.GetTask(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	kind := promptKind
+	if kind == "" {
+		kind = defaultPromptKind(task)
+	}
+	prompt, guidelines, err := composePrompt(client, task, kind, promptWithBrand)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"task_id":          task.ID,
+			"title":            task.Title,
+			"kind":             kind,
+			"prompt":           prompt,
+			"brand_guidelines": guidelines,
+		})
+	}
+
+	system := strings.Join(guidelines, "\n")
+	switch promptFormat {
+	case "markdown":
+		fmt.Printf("# %s prompt: %s\n\n%s\n", strings.ToUpper(kind[:1])+kind[1:], task.Title, prompt)
+		if len(guidelines) > 0 {
+			fmt.Printf("\n## Brand guidelines\n\n%s\n", system)
+		}
+	case "messages":
+		messages := []chatMessage{}
+		if system != "" {
+			messages = append(messages, chatMessage{Role: "system", Content: system})
+		}
+		messages = append(messages, chatMessage{Role: "user", Content: prompt})
+		return outputJSON(map[string]interface{}{"messages": messages})
+	case "anthropic":
+		out := map[string]interface{}{
+			"messages": []chatMessage{{Role: "user", Content: prompt}},
+		}
+		if system != "" {
+			out["system"] = system
+		}
+		return outputJSON(out)
+	default:
+		fmt.Println(plainPrompt(prompt, guidelines))
+	}
+	return nil
+}
+
+// composePrompt returns the task's prompt of the given kind and, if
+// withBrand is set, the brand guidelines to go with it
+func composePrompt(client *api.Client, task *api.Task, kind string, withBrand bool) (string, []string, error) {
+	prompt := strings.TrimSpace(taskPrompt(task, kind))
+	if prompt == "" {
+		return "", nil, fmt.Errorf("task #%d has no %s prompt", task.ID, kind)
+	}
+	if !withBrand {
+		return prompt, nil, nil
+	}
+
+	brand, err := client.GetBrand()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch brand: %w", err)
+	}
+	if brand == nil {
+		return "", nil, fmt.Errorf("no active brand DNA found; set it up in the web app or drop --with-brand")
+	}
+	return prompt, brandGuidelines(brand, kind), nil
+}
+
+// plainPrompt is the prompt followed by any brand guidelines, as one text
+func plainPrompt(prompt string, guidelines []string) string {
+	if len(guidelines) == 0 {
+		return prompt
+	}
+	return prompt + "\n\n" + strings.Join(guidelines, "\n")
+}
+
+// defaultPromptKind picks the prompt matching the task's asset type
+func defaultPromptKind(t *api.Task) string {
+	switch t.AssetType {
+	case "image", "video":
+		return t.AssetType
+	}
+	return "copy"
+}
+
+func taskPrompt(t *api.Task, kind string) string {
+	switch kind {
+	case "image":
+		return t.ImagePrompt
+	case "video":
+		return t.VideoPrompt
+	}
+	return t.CopyPrompt
+}
+
+// brandGuidelines describes the brand as Markdown list items. Copy prompts
+// lead with the voice; image and video prompts with the visual identity.
+func brandGuidelines(brand *api.BrandDNA, kind string) []string {
+	var voice, visual []string
+
+	if v, ok := brand.Voice["voice"].(map[string]interface{}); ok {
+		if tone, ok := v["tone"].(string); ok && tone != "" {
+			voice = append(voice, "- Tone: "+tone)
+		}
+		if personality, ok := v["personality"].([]interface{}); ok && len(personality) > 0 {
+			traits := make([]string, len(personality))
+			for i, p := range personality {
+				traits[i] = fmt.Sprint(p)
+			}
+			voice = append(voice, "- Personality: "+strings.Join(traits, ", "))
+		}
+		if audience, ok := v["target_audience"].(string); ok && audience != "" {
+			voice = append(voice, "- Target audience: "+audience)
+		}
+	}
+	if overrides := currentLocalOverrides(); overrides != nil {
+		for _, addendum := range overrides.VoiceAddenda {
+			voice = append(voice, "- "+addendum)
+		}
+	}
+
+	if palette, ok := brand.Palette["colors"].([]interface{}); ok && len(palette) > 0 {
+		var colors []string
+		for _, c := range palette {
+			if color, ok := c.(map[string]interface{}); ok {
+				s := fmt.Sprintf("%v %v", color["name"], color["hex"])
+				if role, ok := color["role"].(string); ok && role != "" {
+					s += " (" + role + ")"
+				}
+				colors = append(colors, s)
+			}
+		}
+		visual = append(visual, "- Colors: "+strings.Join(colors, ", "))
+	}
+	if fonts, ok := brand.Fonts["fonts"].([]interface{}); ok && len(fonts) > 0 {
+		var names []string
+		for _, f := range fonts {
+			if font, ok := f.(map[string]interface{}); ok {
+				s := fmt.Sprint(font["name"])
+				if usage, ok := font["usage"].(string); ok && usage != "" {
+					s += " (" + usage + ")"
+				}
+				names = append(names, s)
+			}
+		}
+		visual = append(visual, "- Fonts: "+strings.Join(names, ", "))
+	}
+
+	intro := "Follow these brand guidelines"
+	if brand.CompanyName != "" {
+		intro += " for " + brand.CompanyName
+	}
+	lines := []string{intro + ":"}
+	if kind == "copy" {
+		lines = append(lines, voice...)
+		lines = append(lines, visual...)
+	} else {
+		lines = append(lines, visual...)
+		lines = append(lines, voice...)
+	}
+	if len(lines) == 1 {
+		return nil
+	}
+	return lines
+}
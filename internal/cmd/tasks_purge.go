@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var purgeYes bool
+
+var tasksPurgeCmd = &cobra.Command{
+	Use:   "purge <id>...",
+	Short: "Permanently delete discarded tasks",
+	Long: `Permanently delete one or more discarded tasks. This cannot be undone.
+
+Only discarded tasks can be purged; discard a task first with
+'ygm tasks discard'. You are asked to confirm unless --yes is given, which is
+required when not running in a terminal.
+
+Examples:
+  ygm tasks purge 42
+  ygm tasks purge 42 43 --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTasksPurge,
+}
+
+func init() {
+	tasksPurgeCmd.Flags().BoolVarP(&purgeYes, "yes", "y", false, "Don't ask for confirmation")
+}
+
+func runTasksPurge(cmd *cobra.Command, args []string) error {
+	ids, err := parseTaskIDs(args)
+	if err != nil {
+		return err
+	}
+
+	if !purgeYes {
+		labels := make([]string, len(ids))
+		for i, id := range ids {
+			labels[i] = fmt.Sprintf("#%d", id)
+		}
+		ok, err := confirm(fmt.Sprintf("Permanently delete %s? This cannot be undone.", strings.Join(labels, ", ")))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted, nothing was deleted")
+		}
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	cache := loadTaskCache()
+
+	// The purge endpoint is the discard endpoint with a flag, so a task that
+	// isn't discarded yet is never sent to it
+	discarded, err := client.GetTasks(api.TaskFilter{Discarded: true})
+	if err != nil {
+		return fmt.Errorf("failed to fetch discarded tasks: %w", err)
+	}
+	isDiscarded := make(map[int]bool, len(discarded))
+	for _, task := range discarded {
+		isDiscarded[task.ID] = true
+	}
+
+	purged := []int{}
+	failed := []taskFailure{}
+	for _, id := range ids {
+		if !isDiscarded[id] {
+			err = fmt.Errorf("task is not discarded; discard it first with 'ygm tasks discard %d'", id)
+		} else {
+			_, err = client.PurgeTask(id)
+		}
+		if err != nil {
+			failed = append(failed, taskFailure{ID: id, Error: err.Error()})
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "Failed to purge task #%d: %v\n", id, err)
+			}
+			continue
+		}
+		cache.Forget(id)
+		purged = append(purged, id)
+		if !jsonOutput {
+			fmt.Printf("Permanently deleted task #%d\n", id)
+		}
+	}
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"purged": purged, "failed": failed}); err != nil {
+			return err
+		}
+	}
+
+	return failedTasksError(failed, len(ids))
+}
+
+// confirm asks a yes/no question on the terminal. It fails rather than
+// assuming an answer when stdin isn't a terminal.
+func confirm(question string) (bool, error) {
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false, fmt.Errorf("refusing to continue without confirmation; use --yes when not running in a terminal")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestTasksPurgeOnlyDiscarded checks a task that isn't discarded is never
+// sent to the purge endpoint, which would discard it instead
+func TestTasksPurgeOnlyDiscarded(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks" && r.URL.Query().Get("discarded") == "true":
+			fmt.Fprint(w, `{"tasks":[{"id":43,"title":"Old launch","status":"pending"}]}`)
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.String())
+			fmt.Fprint(w, `{"success":true,"message":"purged"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	useTestAccount(t, server.URL)
+
+	err := executeCommand(t, "tasks", "purge", "42", "43", "--yes")
+	if err == nil {
+		t.Fatal("purging a task that isn't discarded succeeded")
+	}
+	if want := []string{"/api/v1/tasks/43?purge=true"}; strings.Join(deleted, "|") != strings.Join(want, "|") {
+		t.Errorf("DELETE requests = %q, want %q", deleted, want)
+	}
+}
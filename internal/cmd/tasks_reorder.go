@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksReorderCmd = &cobra.Command{
+	Use:   "reorder",
+	Short: "Set the order of tasks from a list of IDs",
+	Long: `Read task IDs from stdin, most important first, and give them positions in
+that order. IDs can be separated by spaces, commas or newlines, and may
+start with '#'.
+
+Tasks keep their status; each status group is ordered by how its tasks
+appear in the list. Tasks that aren't listed keep their relative order
+after the listed ones.
+
+Examples:
+  echo 42 17 8 | ygm tasks reorder
+  ygm tasks reorder < priorities.txt
+  ygm tasks --json | jq '.tasks[] | select(.platform == "reddit") | .id' | ygm tasks reorder`,
+	Args: cobra.NoArgs,
+	RunE: runTasksReorder,
+}
+
+func runTasksReorder(cmd *cobra.Command, args []string) error {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read task IDs: %w", err)
+	}
+
+	ids, err := parseOrderedIDs(string(data))
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("no task IDs given on stdin")
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	tasks, err := client.ReorderTasks(ids)
+	if err != nil {
+		return fmt.Errorf("failed to reorder tasks: %w", err)
+	}
+
+	cache := loadTaskCache()
+	for _, t := range tasks {
+		cache.Remember(t)
+	}
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		return outputJSON(tasks)
+	}
+
+	fmt.Printf("Reordered %d tasks\n", len(tasks))
+	sortByPosition(tasks)
+	for _, t := range tasks {
+		fmt.Printf("  %3d. [%d] %s (%s)\n", t.Position, t.ID, t.Title, statusLabel(&t))
+	}
+	return nil
+}
+
+// parseOrderedIDs reads a list of task IDs, keeping their order. Listing a
+// task twice is an error since its position would be ambiguous.
+func parseOrderedIDs(input string) ([]int, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	ids := make([]int, 0, len(fields))
+	seen := make(map[int]bool)
+	for _, field := range fields {
+		id, err := strconv.Atoi(strings.TrimPrefix(field, "#"))
+		if err != nil || id < 1 {
+			return nil, fmt.Errorf("invalid task ID: %s", field)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("task #%d is listed more than once", id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
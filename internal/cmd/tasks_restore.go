@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var tasksRestoreCmd = &cobra.Command{
+	Use:   "restore <id>...",
+	Short: "Restore discarded tasks",
+	Long: `Restore one or more discarded tasks so they show up in the plan again.
+
+Use 'ygm tasks --discarded' to see which tasks can be restored.
+
+Examples:
+  ygm tasks restore 42
+  ygm tasks restore 42 43 44 --json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTasksRestore,
+}
+
+func runTasksRestore(cmd *cobra.Command, args []string) error {
+	ids, err := parseTaskIDs(args)
+	if err != nil {
+		return err
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+	cache := loadTaskCache()
+
+	restored := []api.Task{}
+	failed := []taskFailure{}
+	for _, id := range ids {
+		task, err := client.RestoreTask(id)
+		if err != nil {
+			failed = append(failed, taskFailure{ID: id, Error: err.Error()})
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "Failed to restore task #%d: %v\n", id, err)
+			}
+			continue
+		}
+		cache.Remember(*task)
+		restored = append(restored, *task)
+		if !jsonOutput {
+			fmt.Printf("Restored task #%d: %s\n", task.ID, task.Title)
+		}
+	}
+	saveTaskCache(cache)
+
+	if jsonOutput {
+		if err := outputJSON(map[string]interface{}{"restored": restored, "failed": failed}); err != nil {
+			return err
+		}
+	}
+
+	return failedTasksError(failed, len(ids))
+}
@@ -0,0 +1,480 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/hooks"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var tasksSuggestionsCmd = &cobra.Command{
+	Use:   "suggestions",
+	Short: "Review tasks suggested by the git hooks",
+	Long: `List the tasks 'ygm hooks' queued for marketing-relevant commits in this
+repository, numbered for the subcommands below.
+
+Suggestions are kept in the repository's git directory until you create or
+drop them; nothing is sent until you create them.
+
+Subcommands:
+  edit      Edit the suggestions in $EDITOR as YAML
+  create    Create tasks from suggestions
+  drop      Discard suggestions
+
+Examples:
+  ygm tasks suggestions
+  ygm tasks suggestions edit
+  ygm tasks suggestions create 1 3
+  ygm tasks suggestions drop 2`,
+	Args: cobra.NoArgs,
+	RunE: runTasksSuggestions,
+}
+
+var tasksSuggestionsEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the suggestions in $EDITOR as YAML",
+	Long: `Open the queued suggestions in $VISUAL or $EDITOR as a YAML list.
+
+Change a suggestion's title, description, platform, asset type or
+suggested post date, or delete its entry to drop it. The commit field
+identifies each entry and can't be changed.
+
+If the file can't be read back nothing is saved and your edits are kept in
+a temporary file.`,
+	Args: cobra.NoArgs,
+	RunE: runTasksSuggestionsEdit,
+}
+
+var tasksSuggestionsCreateCmd = &cobra.Command{
+	Use:   "create [n...]",
+	Short: "Create tasks from suggestions",
+	Long: `Create a task from each of the given suggestions, or from all of them,
+and remove them from the queue.
+
+Platform and asset type default to tasks.defaults in .ygm.yml when a
+suggestion doesn't set them. Suggestions whose title already exists in the
+plan are skipped and removed.
+
+Examples:
+  ygm tasks suggestions create
+  ygm tasks suggestions create 2 4 --yes`,
+	RunE: runTasksSuggestionsCreate,
+}
+
+var tasksSuggestionsDropCmd = &cobra.Command{
+	Use:   "drop <n>...",
+	Short: "Discard suggestions",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runTasksSuggestionsDrop,
+}
+
+var suggestionsYes bool
+
+func init() {
+	tasksSuggestionsCreateCmd.Flags().BoolVarP(&suggestionsYes, "yes", "y", false, "Create the tasks without asking")
+	tasksSuggestionsCmd.AddCommand(tasksSuggestionsEditCmd)
+	tasksSuggestionsCmd.AddCommand(tasksSuggestionsCreateCmd)
+	tasksSuggestionsCmd.AddCommand(tasksSuggestionsDropCmd)
+}
+
+func runTasksSuggestions(cmd *cobra.Command, args []string) error {
+	queue, err := hooks.LoadQueue(".")
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"suggestions": queue.Suggestions})
+	}
+
+	if len(queue.Suggestions) == 0 {
+		fmt.Println("No suggestions queued.")
+		if dir, err := hooks.Dir("."); err == nil {
+			if s, err := hooks.Check(dir, "post-commit"); err == nil && !s.Installed {
+				if s, err := hooks.Check(dir, "pre-push"); err == nil && !s.Installed {
+					fmt.Println("Install the git hooks with 'ygm hooks install' to get some.")
+				}
+			}
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tCOMMIT\tPLATFORM\tDATE\tTITLE\tREASON")
+	for i, s := range queue.Suggestions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", i+1, shortHash(s.Commit),
+			orDash(s.Platform), orDash(s.Date), clip(s.Title, 50), s.Reason)
+	}
+	return w.Flush()
+}
+
+// editedSuggestion is a suggestion as written to the editor
+type editedSuggestion struct {
+	Commit      string `yaml:"commit"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	Platform    string `yaml:"platform"`
+	AssetType   string `yaml:"asset_type"`
+	Date        string `yaml:"suggested_post_date"`
+}
+
+const suggestionsEditHeader = `# Edit the suggested tasks below, then save and close the file.
+# Delete an entry to drop it. Don't change the commit lines.
+`
+
+func runTasksSuggestionsEdit(cmd *cobra.Command, args []string) error {
+	queue, err := hooks.LoadQueue(".")
+	if err != nil {
+		return err
+	}
+	if len(queue.Suggestions) == 0 {
+		fmt.Println("No suggestions queued.")
+		return nil
+	}
+
+	edits := make([]editedSuggestion, 0, len(queue.Suggestions))
+	for _, s := range queue.Suggestions {
+		edits = append(edits, editedSuggestion{
+			Commit:      s.Commit,
+			Title:       s.Title,
+			Description: s.Description,
+			Platform:    s.Platform,
+			AssetType:   s.AssetType,
+			Date:        s.Date,
+		})
+	}
+	content, err := yaml.Marshal(edits)
+	if err != nil {
+		return fmt.Errorf("failed to render suggestions: %w", err)
+	}
+
+	tmp, err := os.CreateTemp("", "ygm-suggestions-*.yml")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, err = tmp.Write(append([]byte(suggestionsEditHeader), content...))
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := openEditor(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to read edited suggestions: %w", err)
+	}
+
+	updated, err := applySuggestionEdits(queue.Suggestions, edited)
+	if err != nil {
+		return fmt.Errorf("%w (your edits are in %s)", err, tmpPath)
+	}
+	// Remember dropped commits so the pre-push hook doesn't queue them again
+	kept := make(map[string]bool, len(updated))
+	for _, u := range updated {
+		kept[u.Commit] = true
+	}
+	listed := make(map[string]bool, len(queue.Suggestions))
+	var dropped []string
+	for _, s := range queue.Suggestions {
+		listed[s.Commit] = true
+		if !kept[s.Commit] {
+			dropped = append(dropped, s.Commit)
+		}
+	}
+
+	queue, err = hooks.UpdateQueue(".", func(q *hooks.Queue) error {
+		// The queue may have changed while the editor was open: skip
+		// suggestions created or dropped meanwhile and keep new ones
+		latest := q.Suggestions
+		current := make(map[string]bool, len(latest))
+		for _, s := range latest {
+			current[s.Commit] = true
+		}
+		q.Suggestions = nil
+		for _, u := range updated {
+			if current[u.Commit] {
+				q.Suggestions = append(q.Suggestions, u)
+			}
+		}
+		q.Remove(dropped...)
+		for _, s := range latest {
+			if !listed[s.Commit] {
+				q.Add(s)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w (your edits are in %s)", err, tmpPath)
+	}
+	os.Remove(tmpPath)
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"suggestions": queue.Suggestions})
+	}
+	fmt.Printf("Saved %d %s", len(queue.Suggestions), plural(len(queue.Suggestions), "suggestion", "suggestions"))
+	if len(dropped) > 0 {
+		fmt.Printf(", dropped %d", len(dropped))
+	}
+	fmt.Println()
+	return nil
+}
+
+// applySuggestionEdits returns the suggestions as edited, in the edited
+// order. Entries removed from the file are dropped.
+func applySuggestionEdits(original []hooks.Suggestion, data []byte) ([]hooks.Suggestion, error) {
+	var edits []editedSuggestion
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&edits); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid suggestions: %w", err)
+	}
+
+	byCommit := make(map[string]hooks.Suggestion, len(original))
+	for _, s := range original {
+		byCommit[s.Commit] = s
+	}
+
+	updated := make([]hooks.Suggestion, 0, len(edits))
+	seen := make(map[string]bool, len(edits))
+	for i, e := range edits {
+		s, ok := byCommit[e.Commit]
+		if !ok {
+			return nil, fmt.Errorf("entry %d: unknown commit %q", i+1, e.Commit)
+		}
+		if seen[e.Commit] {
+			return nil, fmt.Errorf("entry %d: commit %s is listed twice", i+1, shortHash(e.Commit))
+		}
+		seen[e.Commit] = true
+
+		if strings.TrimSpace(e.Title) == "" {
+			return nil, fmt.Errorf("entry %d: title can't be empty", i+1)
+		}
+		if e.Date != "" {
+			if _, err := time.Parse("2006-01-02", e.Date); err != nil {
+				return nil, fmt.Errorf("entry %d: invalid suggested_post_date %q (expected YYYY-MM-DD)", i+1, e.Date)
+			}
+		}
+
+		s.Title = strings.TrimSpace(e.Title)
+		s.Description = strings.TrimSpace(e.Description)
+		s.Platform = strings.TrimSpace(e.Platform)
+		s.AssetType = strings.TrimSpace(e.AssetType)
+		s.Date = e.Date
+		updated = append(updated, s)
+	}
+	return updated, nil
+}
+
+func runTasksSuggestionsCreate(cmd *cobra.Command, args []string) error {
+	queue, err := hooks.LoadQueue(".")
+	if err != nil {
+		return err
+	}
+	if len(queue.Suggestions) == 0 {
+		fmt.Println("No suggestions queued.")
+		return nil
+	}
+
+	indexes, err := suggestionIndexes(args, len(queue.Suggestions))
+	if err != nil {
+		return err
+	}
+
+	account, err := getActiveAccount()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(account.APIURL, account.Token)
+
+	// Skip suggestions that were already created
+	existing, err := client.GetTasks(api.TaskFilter{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing tasks: %w", err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		seen[duplicateKey(t.Title, nil)] = true
+	}
+
+	var pending, skipped []int
+	for _, i := range indexes {
+		if seen[duplicateKey(queue.Suggestions[i].Title, nil)] {
+			skipped = append(skipped, i)
+		} else {
+			pending = append(pending, i)
+		}
+	}
+
+	if !jsonOutput {
+		for _, i := range skipped {
+			fmt.Printf("Skipping %q: a task with that title already exists\n", queue.Suggestions[i].Title)
+		}
+	}
+
+	if len(pending) > 0 && !suggestionsYes {
+		for _, i := range pending {
+			fmt.Fprintf(os.Stderr, "  %d. %s\n", i+1, queue.Suggestions[i].Title)
+		}
+		ok, err := confirm(fmt.Sprintf("Create %d %s?", len(pending), plural(len(pending), "task", "tasks")))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Nothing created.")
+			return nil
+		}
+	}
+
+	// Created and skipped suggestions leave the queue, even if a later one
+	// fails
+	var done []string
+	for _, i := range skipped {
+		done = append(done, queue.Suggestions[i].Commit)
+	}
+	created := []api.Task{}
+	var createErr error
+	for _, i := range pending {
+		task, err := client.CreateTask(suggestionRequest(queue.Suggestions[i]))
+		if err != nil {
+			createErr = fmt.Errorf("failed to create %q (%d created before it): %w", queue.Suggestions[i].Title, len(created), err)
+			break
+		}
+		done = append(done, queue.Suggestions[i].Commit)
+		created = append(created, *task)
+		if !jsonOutput {
+			fmt.Printf("Created task #%d: %s\n", task.ID, task.Title)
+		}
+	}
+
+	queue, err = hooks.UpdateQueue(".", func(q *hooks.Queue) error {
+		q.Remove(done...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if createErr != nil {
+		return createErr
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"created":   created,
+			"skipped":   len(skipped),
+			"remaining": len(queue.Suggestions),
+		})
+	}
+	if n := len(queue.Suggestions); n > 0 {
+		fmt.Printf("%d %s left in the queue\n", n, plural(n, "suggestion", "suggestions"))
+	}
+	return nil
+}
+
+// suggestionRequest turns a suggestion into a new task, falling back to the
+// project's defaults from .ygm.yml
+func suggestionRequest(s hooks.Suggestion) api.CreateTaskRequest {
+	req := api.CreateTaskRequest{
+		Title:       s.Title,
+		Description: s.Description,
+		Platform:    s.Platform,
+		AssetType:   s.AssetType,
+	}
+	if localCfg != nil {
+		if req.Platform == "" {
+			req.Platform = localCfg.Tasks.Defaults.Platform
+		}
+		if req.AssetType == "" {
+			req.AssetType = localCfg.Tasks.Defaults.AssetType
+		}
+	}
+	if s.Date != "" {
+		date := s.Date
+		req.SuggestedPostDate = &date
+	}
+	return req
+}
+
+func runTasksSuggestionsDrop(cmd *cobra.Command, args []string) error {
+	var dropped int
+	queue, err := hooks.UpdateQueue(".", func(q *hooks.Queue) error {
+		indexes, err := suggestionIndexes(args, len(q.Suggestions))
+		if err != nil {
+			return err
+		}
+		commits := make([]string, len(indexes))
+		for j, i := range indexes {
+			commits[j] = q.Suggestions[i].Commit
+		}
+		q.Remove(commits...)
+		dropped = len(commits)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{"suggestions": queue.Suggestions})
+	}
+	fmt.Printf("Dropped %d %s\n", dropped, plural(dropped, "suggestion", "suggestions"))
+	return nil
+}
+
+// suggestionIndexes converts the 1-based numbers shown by 'ygm tasks
+// suggestions' to indexes. No numbers means every suggestion.
+func suggestionIndexes(args []string, n int) ([]int, error) {
+	if len(args) == 0 {
+		indexes := make([]int, n)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes, nil
+	}
+
+	seen := make(map[int]bool, len(args))
+	indexes := make([]int, 0, len(args))
+	for _, arg := range args {
+		num, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+		if err != nil || num < 1 || num > n {
+			return nil, fmt.Errorf("invalid suggestion number: %s (expected 1-%d)", arg, n)
+		}
+		if !seen[num-1] {
+			seen[num-1] = true
+			indexes = append(indexes, num-1)
+		}
+	}
+	return indexes, nil
+}
+
+// shortHash abbreviates a commit hash for display
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
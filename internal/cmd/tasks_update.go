@@ -2,28 +2,52 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/taskcache"
 	"github.com/spf13/cobra"
 )
 
 var (
-	updateTitle       string
-	updateDescription string
-	updateStatus      string
+	updateTitle            string
+	updateDescription      string
+	updateStatus           string
+	updatePlatform         string
+	updateAssetType        string
+	updateDate             string
+	updateClearDate        bool
+	updateClearDescription bool
+	updateForce            bool
+	updateWhere            string
+	updateConcurrency      int
 )
 
 var tasksUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
-	Short: "Update a marketing task",
-	Long: `Update title, description, or status of an existing marketing task.
+	Use:   "update <id>... | --where <conditions>",
+	Short: "Update marketing tasks",
+	Long: `Update the fields of one or more existing marketing tasks.
+
+Tasks are given as IDs, ranges like 10-15, or selected with --where. Several
+tasks are updated concurrently (see --concurrency), backing off when the API
+rate-limits requests. A result per task is printed as a table, or as one line
+of JSON per task with --json, and the command fails if any task failed.
+
+Only the fields given as flags are changed. Use --clear-description and
+--clear-date to remove a description or suggested post date.
+
+If this CLI has read the task before (e.g. with 'ygm tasks'), the update is
+only applied if nobody changed the task since. Otherwise it fails with a
+conflict showing your changes next to the server's; use --force to
+overwrite anyway.
 
 Examples:
   ygm tasks update 42 --title "New title"
   ygm tasks update 42 --status completed
-  ygm tasks update 42 --title "Updated" --description "New description" --status in_progress`,
-	Args: cobra.ExactArgs(1),
+  ygm tasks update 42 --platform linkedin --asset-type copy --date 2026-03-01
+  ygm tasks update 42 --clear-date --clear-description
+  ygm tasks update 42 --title "Updated" --description "New description" --status in_progress
+  ygm tasks update 42 43 50-55 --status completed
+  ygm tasks update --where status=pending,platform=twitter --status in_progress`,
 	RunE: runTasksUpdate,
 }
 
@@ -31,16 +55,24 @@ func init() {
 	tasksUpdateCmd.Flags().StringVar(&updateTitle, "title", "", "New task title")
 	tasksUpdateCmd.Flags().StringVar(&updateDescription, "description", "", "New task description")
 	tasksUpdateCmd.Flags().StringVar(&updateStatus, "status", "", "New status (pending, in_progress, completed)")
+	tasksUpdateCmd.Flags().StringVar(&updatePlatform, "platform", "", "New platform (twitter, instagram, linkedin, reddit, etc.)")
+	tasksUpdateCmd.Flags().StringVar(&updateAssetType, "asset-type", "", "New asset type (image, copy, video)")
+	tasksUpdateCmd.Flags().StringVar(&updateDate, "date", "", "New suggested post date (YYYY-MM-DD)")
+	tasksUpdateCmd.Flags().BoolVar(&updateClearDate, "clear-date", false, "Remove the suggested post date")
+	tasksUpdateCmd.Flags().BoolVar(&updateClearDescription, "clear-description", false, "Remove the description")
+	tasksUpdateCmd.Flags().BoolVar(&updateForce, "force", false, "Update even if the task changed since it was last read")
+	addBulkFlags(tasksUpdateCmd, &updateWhere, &updateConcurrency)
+	tasksUpdateCmd.MarkFlagsMutuallyExclusive("date", "clear-date")
+	tasksUpdateCmd.MarkFlagsMutuallyExclusive("description", "clear-description")
 }
 
 func runTasksUpdate(cmd *cobra.Command, args []string) error {
-	id, err := strconv.Atoi(args[0])
+	req, err := updateRequestFromFlags(cmd)
 	if err != nil {
-		return fmt.Errorf("invalid task ID: %s", args[0])
+		return err
 	}
-
-	if updateTitle == "" && updateDescription == "" && updateStatus == "" {
-		return fmt.Errorf("at least one of --title, --description, or --status is required")
+	if req.IsEmpty() {
+		return fmt.Errorf("at least one of --title, --description, --status, --platform, --asset-type, --date, --clear-date or --clear-description is required")
 	}
 
 	account, err := getActiveAccount()
@@ -48,18 +80,47 @@ func runTasksUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := api.NewClient(cfg.APIURL, account.Token)
+	client := api.NewClient(account.APIURL, account.Token)
+	cache := loadTaskCache()
+
+	ids, err := selectTaskIDs(client, args, updateWhere, cache)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 1 && updateWhere == "" {
+		return updateOneTask(client, cache, ids[0], req)
+	}
+
+	defer saveTaskCache(cache)
+	return runBulk(ids, updateConcurrency, func(id int) bulkResult {
+		var cond *api.Precondition
+		if !updateForce {
+			cond = cache.Precondition(id)
+		}
+
+		task, err := client.UpdateTask(id, req, cond)
+		if err != nil {
+			return bulkResult{ID: id, Error: bulkError(err, "--force")}
+		}
+		cache.Remember(*task)
+		return bulkResult{ID: id, OK: true, Task: task}
+	})
+}
 
-	req := api.UpdateTaskRequest{
-		Title:       updateTitle,
-		Description: updateDescription,
-		Status:      updateStatus,
+// updateOneTask updates a single task, showing both versions on a conflict
+func updateOneTask(client *api.Client, cache *taskcache.Cache, id int, req api.UpdateTaskRequest) error {
+	var cond *api.Precondition
+	if !updateForce {
+		cond = cache.Precondition(id)
 	}
 
-	task, err := client.UpdateTask(id, req)
+	task, err := client.UpdateTask(id, req, cond)
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return fmt.Errorf("failed to update task: %w", explainConflict(err, req, cond, "re-run with --force to overwrite"))
 	}
+	cache.Remember(*task)
+	saveTaskCache(cache)
 
 	if jsonOutput {
 		return outputJSON(task)
@@ -69,3 +130,40 @@ func runTasksUpdate(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// updateRequestFromFlags builds an update containing only the flags that
+// were given on the command line
+func updateRequestFromFlags(cmd *cobra.Command) (api.UpdateTaskRequest, error) {
+	var req api.UpdateTaskRequest
+	flags := cmd.Flags()
+
+	if flags.Changed("title") {
+		if updateTitle == "" {
+			return req, fmt.Errorf("--title can't be empty")
+		}
+		req.Title = api.Set(updateTitle)
+	}
+	if flags.Changed("description") {
+		req.Description = api.Set(updateDescription)
+	}
+	if updateClearDescription {
+		req.Description = api.Null[string]()
+	}
+	if flags.Changed("status") {
+		req.Status = api.Set(updateStatus)
+	}
+	if flags.Changed("platform") {
+		req.Platform = api.Set(updatePlatform)
+	}
+	if flags.Changed("asset-type") {
+		req.AssetType = api.Set(updateAssetType)
+	}
+	if flags.Changed("date") {
+		req.SuggestedPostDate = api.Set(updateDate)
+	}
+	if updateClearDate {
+		req.SuggestedPostDate = api.Null[string]()
+	}
+
+	return req, nil
+}
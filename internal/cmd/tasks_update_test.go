@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// TestTasksUpdateRequestBody checks the exact PATCH body 'ygm tasks update'
+// sends: flags not given are absent, --clear-* flags send null and value
+// flags send the value
+func TestTasksUpdateRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "clear date",
+			args: []string{"--clear-date"},
+			want: `{"task":{"suggested_post_date":null}}`,
+		},
+		{
+			name: "clear description",
+			args: []string{"--clear-description"},
+			want: `{"task":{"description":null}}`,
+		},
+		{
+			name: "platform",
+			args: []string{"--platform", "linkedin"},
+			want: `{"task":{"platform":"linkedin"}}`,
+		},
+		{
+			name: "empty platform is sent as a value",
+			args: []string{"--platform", ""},
+			want: `{"task":{"platform":""}}`,
+		},
+		{
+			name: "clear and set together",
+			args: []string{"--clear-date", "--clear-description", "--platform", "twitter"},
+			want: `{"task":{"description":null,"platform":"twitter","suggested_post_date":null}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method, path, body string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				method, path, body = r.Method, r.URL.Path, string(data)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id":42,"title":"Launch","status":"pending"}`)
+			}))
+			defer server.Close()
+
+			useTestAccount(t, server.URL)
+			args := append([]string{"tasks", "update", "42"}, tt.args...)
+			if err := executeCommand(t, args...); err != nil {
+				t.Fatalf("ygm %v: %v", args, err)
+			}
+
+			if method != http.MethodPatch || path != "/api/v1/tasks/42" {
+				t.Errorf("request = %s %s, want PATCH /api/v1/tasks/42", method, path)
+			}
+			if body != tt.want {
+				t.Errorf("body =\n  %s\nwant\n  %s", body, tt.want)
+			}
+		})
+	}
+}
+
+// useTestAccount writes a global config with one account using apiURL and
+// moves to an empty directory so no .ygm.yml or task cache applies
+func useTestAccount(t *testing.T, apiURL string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(dir, "cache"))
+	t.Setenv("HOME", dir)
+	t.Setenv("AppData", filepath.Join(dir, "config"))
+	t.Setenv("LocalAppData", filepath.Join(dir, "cache"))
+	t.Setenv("YGM_ORG", "")
+	t.Setenv("YGM_API_URL", "")
+
+	configDir := filepath.Join(dir, "config", "ygm")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	config := fmt.Sprintf(`version: 2
+default_org: acme
+api_url: %s
+accounts:
+  acme:
+    token: ygm_test
+    user_email: dev@acme.test
+    org_id: 1
+    org_name: Acme
+    api_url: %s
+`, apiURL, apiURL)
+	if err := os.WriteFile(filepath.Join(configDir, "config.yml"), []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	work := filepath.Join(dir, "work")
+	if err := os.Mkdir(work, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// Stop .ygm.yml lookups here rather than in the directories above
+	if err := os.Mkdir(filepath.Join(work, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(work); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(previous) })
+}
+
+// executeCommand runs ygm with args, resetting flags left over from
+// earlier runs in the same test binary
+func executeCommand(t *testing.T, args ...string) error {
+	t.Helper()
+
+	resetFlags(rootCmd)
+	rootCmd.SetArgs(args)
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	return rootCmd.Execute()
+}
+
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			var values []string
+			if def := strings.Trim(f.DefValue, "[]"); def != "" {
+				values = strings.Split(def, ",")
+			}
+			sv.Replace(values)
+		} else {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, child := range cmd.Commands() {
+		resetFlags(child)
+	}
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// defaultTerminalWidth is assumed when stdout isn't a terminal
+const defaultTerminalWidth = 80
+
+// terminalWidth returns the width of stdout in columns. $COLUMNS overrides
+// the detected width.
+func terminalWidth() int {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
+
+// useColor reports whether to color output: only on a terminal, and never
+// when NO_COLOR is set (https://no-color.org)
+func useColor() bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// ANSI foreground colors used for platforms
+var platformColors = map[string]int{
+	"twitter":   36, // Cyan
+	"x":         36,
+	"linkedin":  34, // Blue
+	"instagram": 35, // Magenta
+	"facebook":  94, // Bright blue
+	"reddit":    31, // Red
+	"tiktok":    95, // Bright magenta
+	"youtube":   91, // Bright red
+	"blog":      32, // Green
+	"email":     33, // Yellow
+}
+
+// fallbackColors are assigned to other platforms by hashing their name
+var fallbackColors = []int{92, 93, 96, 90}
+
+// platformColor returns the ANSI color for a platform
+func platformColor(platform string) int {
+	if c, ok := platformColors[platform]; ok {
+		return c
+	}
+	h := fnv.New32a()
+	h.Write([]byte(platform))
+	return fallbackColors[h.Sum32()%uint32(len(fallbackColors))]
+}
+
+// colorize wraps s in an ANSI color if color is enabled
+func colorize(s string, color int, enabled bool) string {
+	if !enabled || color == 0 {
+		return s
+	}
+	return fmt.Sprintf("\x1b[%dm%s\x1b[0m", color, s)
+}
+
+// clip truncates s to at most width columns, marking the cut with an ellipsis
+func clip(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	if width == 1 {
+		return string(r[:1])
+	}
+	return string(r[:width-1]) + "…"
+}
+
+// fit truncates or pads s to exactly width columns
+func fit(s string, width int) string {
+	s = clip(s, width)
+	if n := utf8.RuneCountInString(s); n < width {
+		s += strings.Repeat(" ", width-n)
+	}
+	return s
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/CromulentConsulting/ygm-cli/internal/config"
 	"github.com/CromulentConsulting/ygm-cli/internal/skills"
@@ -12,7 +14,15 @@ import (
 var unlinkCmd = &cobra.Command{
 	Use:   "unlink",
 	Short: "Unlink this project from its organization",
-	Long: `Remove the .ygm.yml file from the current project.
+	Long: `Remove the organization from the project's .ygm.yml.
+
+Only the org setting is removed; task defaults and filters, context
+sections, voice addenda and hook rules in the same file are kept. The file
+is deleted only if nothing else is left in it.
+
+If the organization comes from a parent .ygm.yml shared with other
+directories, or from one outside the project, nothing is changed: run
+unlink from that file's directory instead.
 
 After unlinking, the CLI will use the default organization from your
 global config (~/.config/ygm/config.yml) or require --org flag.`,
@@ -20,31 +30,62 @@ global config (~/.config/ygm/config.yml) or require --org flag.`,
 }
 
 func runUnlink(cmd *cobra.Command, args []string) error {
-	path, err := config.LocalConfigPath()
+	local, err := config.LoadLocal()
 	if err != nil {
-		return err
+		return fmt.Errorf("%w; fix it with 'ygm config edit --local' first", err)
 	}
-
-	if path == "" {
-		fmt.Println("No local config found. Project is not linked.")
+	if local == nil || local.Org == "" {
+		fmt.Println("No local config links this project. Project is not linked.")
 		return nil
 	}
 
-	// Show what we're removing
-	localCfg, _ := config.LoadLocal()
-	if localCfg != nil {
-		fmt.Printf("Unlinking from '%s'\n", localCfg.Org)
+	// Only the nearest layer belongs to this directory; clearing org in a
+	// parent layer would unlink every project that shares it
+	path := local.Source("org")
+	if path != local.Path() {
+		return fmt.Errorf("'%s' is inherited from %s, which other directories share; run 'ygm unlink' in %s to unlink them all",
+			local.Org, path, filepath.Dir(path))
+	}
+	root, err := config.LocalRoot()
+	if err != nil {
+		return err
+	}
+	if !isWithin(filepath.Dir(path), root) {
+		return fmt.Errorf("'%s' is set by %s, outside this project (%s); run 'ygm unlink' in %s to remove it",
+			local.Org, path, root, filepath.Dir(path))
 	}
 
-	if err := config.RemoveLocal(); err != nil {
-		return fmt.Errorf("failed to remove local config: %w", err)
+	fmt.Printf("Unlinking from '%s'\n", local.Org)
+
+	// Clear only org, under the file's lock, keeping the other settings
+	layer, err := config.UpdateLocalFile(path, func(layer *config.LocalConfig) error {
+		layer.Org = ""
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update local config: %w", err)
 	}
 
-	// Remove local agent skills
-	if err := skills.RemoveLocal(); err != nil {
+	// Remove local agent skills installed by link
+	if err := skills.RemoveAt(filepath.Dir(path)); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: could not remove local agent skills: %v\n", err)
 	}
 
-	fmt.Printf("Removed %s\n", path)
+	if layer.IsEmpty() {
+		fmt.Printf("Removed %s\n", path)
+	} else {
+		fmt.Printf("Removed org from %s (other settings kept)\n", path)
+	}
+
+	// A parent layer may still link the project to an organization
+	if after, err := config.LoadLocal(); err == nil && after != nil && after.Org != "" {
+		fmt.Fprintf(os.Stderr, "Warning: still linked to '%s' by %s\n", after.Org, after.Source("org"))
+	}
 	return nil
 }
+
+// isWithin reports whether dir is root or inside it
+func isWithin(dir, root string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestUnlink checks unlink only clears org in the project's own .ygm.yml
+// and leaves files shared with other directories alone
+func TestUnlink(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string // Relative to the git root; ../ is above it
+		cwd     string            // Relative to the git root
+		wantErr string
+		want    map[string]string // Expected contents afterwards; "" means removed
+	}{
+		{
+			name:  "other settings are kept",
+			files: map[string]string{".ygm.yml": "org: acme\ntasks:\n  defaults:\n    platform: linkedin\n"},
+			want:  map[string]string{".ygm.yml": "tasks:\n    defaults:\n        platform: linkedin\n"},
+		},
+		{
+			name:  "file with only org is removed",
+			files: map[string]string{".ygm.yml": "org: acme\n"},
+			want:  map[string]string{".ygm.yml": ""},
+		},
+		{
+			name: "org inherited from a parent layer",
+			files: map[string]string{
+				".ygm.yml":         "org: acme\n",
+				"pkg/web/.ygm.yml": "hooks:\n  types:\n    - feat\n",
+			},
+			cwd:     "pkg/web",
+			wantErr: "inherited from",
+			want: map[string]string{
+				".ygm.yml":         "org: acme\n",
+				"pkg/web/.ygm.yml": "hooks:\n  types:\n    - feat\n",
+			},
+		},
+		{
+			name: "parent layer still sets org",
+			files: map[string]string{
+				".ygm.yml":     "org: acme\n",
+				"pkg/.ygm.yml": "org: globex\n",
+			},
+			cwd:  "pkg",
+			want: map[string]string{".ygm.yml": "org: acme\n", "pkg/.ygm.yml": ""},
+		},
+		{
+			name:    "file outside the project",
+			files:   map[string]string{"../.ygm.yml": "org: acme\n"},
+			wantErr: "outside this project",
+			want:    map[string]string{"../.ygm.yml": "org: acme\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTestAccount(t, "http://127.0.0.1:1")
+			root, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, content := range tt.files {
+				path := filepath.Join(root, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.cwd != "" {
+				if err := os.Chdir(filepath.Join(root, tt.cwd)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = executeCommand(t, "unlink")
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unlink: %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("unlink error = %v, want one containing %q", err, tt.wantErr)
+			}
+
+			for name, want := range tt.want {
+				data, err := os.ReadFile(filepath.Join(root, name))
+				if want == "" {
+					if !os.IsNotExist(err) {
+						t.Errorf("%s still exists: %q", name, data)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("%s: %v", name, err)
+				} else if string(data) != want {
+					t.Errorf("%s =\n%s\nwant\n%s", name, data, want)
+				}
+			}
+		})
+	}
+}
@@ -9,16 +9,20 @@ import (
 )
 
 const (
-	ConfigVersion   = 1
+	ConfigVersion   = 2
 	DefaultAPIURL   = "https://youvegotmarketing.com"
 	LocalConfigFile = ".ygm.yml"
+
+	// Environment variables that override config file settings
+	EnvOrg    = "YGM_ORG"
+	EnvAPIURL = "YGM_API_URL"
 )
 
 // Config represents the CLI configuration stored in ~/.config/ygm/config.yml
 type Config struct {
 	Version    int                `yaml:"version"`
 	DefaultOrg string             `yaml:"default_org,omitempty"`
-	APIURL     string             `yaml:"api_url"`
+	APIURL     string             `yaml:"api_url"` // Default for accounts without their own api_url
 	Accounts   map[string]Account `yaml:"accounts"`
 }
 
@@ -28,6 +32,7 @@ type Account struct {
 	UserEmail string `yaml:"user_email"`
 	OrgID     int    `yaml:"org_id"`
 	OrgName   string `yaml:"org_name"`
+	APIURL    string `yaml:"api_url"`
 }
 
 // ConfigPath returns the path to the config file
@@ -45,27 +50,113 @@ func ConfigPath() (string, error) {
 	return filepath.Join(configDir, "ygm", "config.yml"), nil
 }
 
-// Load reads the config from disk
+// Load reads the config from disk, migrating older config versions in place
 func Load() (*Config, error) {
 	path, err := ConfigPath()
 	if err != nil {
 		return nil, err
 	}
 
+	cfg, outdated, err := readConfig(path, false)
+	if err != nil || !outdated {
+		return cfg, err
+	}
+
+	// Migrate only under the lock, re-reading so a concurrent migration or
+	// save isn't clobbered
+	lock, err := LockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	cfg, migrated, err := readConfig(path, true)
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		if err := cfg.save(path); err != nil {
+			return nil, fmt.Errorf("failed to save migrated config: %w", err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Update runs a locked read-modify-write cycle on the config: it loads the
+// current config from disk (or a new one if none exists), applies fn and
+// saves the result. Use it instead of Load followed by Save whenever other
+// ygm processes may be writing the config at the same time.
+func Update(fn func(*Config) error) (*Config, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	lock, err := LockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	cfg, _, err := readConfig(path, true)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		cfg = NewConfig()
+	}
+
+	if err := fn(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.save(path); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// readConfig parses the config at path. It returns nil if the file doesn't
+// exist. An older config is migrated (writing its backup) only if
+// migrateOld is set, which requires holding the config lock; otherwise it
+// returns nil and true so the caller can lock and read again. The bool
+// reports whether the config was, or needs to be, migrated.
+func readConfig(path string, migrateOld bool) (*Config, bool, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, nil // No config yet
+			return nil, false, nil // No config yet
 		}
-		return nil, fmt.Errorf("failed to read config: %w", err)
+		return nil, false, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if !migrateOld {
+		outdated, err := needsMigration(path, data)
+		if err != nil || outdated {
+			return nil, outdated, err
+		}
+	}
+
+	migrated, err := migrate(path, data)
+	if err != nil {
+		return nil, false, err
+	}
+	if migrated != nil {
+		data = migrated
 	}
 
 	var cfg Config
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config: %w", err)
+		return nil, false, fmt.Errorf("failed to parse config: %w", err)
 	}
 
-	return &cfg, nil
+	return &cfg, migrated != nil, nil
 }
 
 // Save writes the config to disk with secure permissions
@@ -81,13 +172,24 @@ func (c *Config) Save() error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	lock, err := LockFile(path)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	return c.save(path)
+}
+
+// save writes the config to path; the caller must hold the config lock
+func (c *Config) save(path string) error {
 	data, err := yaml.Marshal(c)
 	if err != nil {
 		return fmt.Errorf("failed to serialize config: %w", err)
 	}
 
 	// Write with secure permissions (0600 = owner read/write only)
-	if err := os.WriteFile(path, data, 0600); err != nil {
+	if err := WriteFileAtomic(path, data, 0600); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
@@ -95,10 +197,14 @@ func (c *Config) Save() error {
 }
 
 // AddAccount adds or updates an account in the config
+// Accounts without an API URL use the global default
 func (c *Config) AddAccount(slug string, account Account) {
 	if c.Accounts == nil {
 		c.Accounts = make(map[string]Account)
 	}
+	if account.APIURL == "" {
+		account.APIURL = c.GlobalAPIURL()
+	}
 	c.Accounts[slug] = account
 
 	// Set as default if it's the first account
@@ -107,92 +213,28 @@ func (c *Config) AddAccount(slug string, account Account) {
 	}
 }
 
-// NewConfig creates a new config with defaults
-func NewConfig() *Config {
-	return &Config{
-		Version:  ConfigVersion,
-		APIURL:   DefaultAPIURL,
-		Accounts: make(map[string]Account),
+// GlobalAPIURL returns the global API URL, falling back to the built-in default
+func (c *Config) GlobalAPIURL() string {
+	if c.APIURL != "" {
+		return c.APIURL
 	}
+	return DefaultAPIURL
 }
 
-// LocalConfig represents project-specific config stored in .ygm.yml
-type LocalConfig struct {
-	Org string `yaml:"org"` // Organization slug to use for this project
-}
-
-// LocalConfigPath returns the path to the local config file in the current directory
-// It walks up the directory tree to find .ygm.yml (like .git)
-func LocalConfigPath() (string, error) {
-	dir, err := os.Getwd()
-	if err != nil {
-		return "", err
-	}
-
-	for {
-		path := filepath.Join(dir, LocalConfigFile)
-		if _, err := os.Stat(path); err == nil {
-			return path, nil
-		}
-
-		parent := filepath.Dir(dir)
-		if parent == dir {
-			// Reached root, no local config found
-			return "", nil
-		}
-		dir = parent
+// AccountAPIURL returns the API URL for an account, falling back to the
+// global default for accounts that don't specify one
+func (c *Config) AccountAPIURL(account Account) string {
+	if account.APIURL != "" {
+		return account.APIURL
 	}
+	return c.GlobalAPIURL()
 }
 
-// LoadLocal reads the local config from the current directory or parents
-func LoadLocal() (*LocalConfig, error) {
-	path, err := LocalConfigPath()
-	if err != nil {
-		return nil, err
-	}
-
-	if path == "" {
-		return nil, nil // No local config
-	}
-
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read local config: %w", err)
-	}
-
-	var cfg LocalConfig
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse local config: %w", err)
-	}
-
-	return &cfg, nil
-}
-
-// SaveLocal writes the local config to the current directory
-func (c *LocalConfig) Save() error {
-	data, err := yaml.Marshal(c)
-	if err != nil {
-		return fmt.Errorf("failed to serialize local config: %w", err)
-	}
-
-	// Write to current directory
-	if err := os.WriteFile(LocalConfigFile, data, 0644); err != nil {
-		return fmt.Errorf("failed to write local config: %w", err)
-	}
-
-	return nil
-}
-
-// RemoveLocal deletes the local config file
-func RemoveLocal() error {
-	path, err := LocalConfigPath()
-	if err != nil {
-		return err
-	}
-
-	if path == "" {
-		return fmt.Errorf("no local config found")
+// NewConfig creates a new config with defaults
+func NewConfig() *Config {
+	return &Config{
+		Version:  ConfigVersion,
+		APIURL:   DefaultAPIURL,
+		Accounts: make(map[string]Account),
 	}
-
-	return os.Remove(path)
 }
@@ -0,0 +1,405 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scope identifies which config file a setting lives in
+type Scope string
+
+const (
+	ScopeGlobal Scope = "global" // ~/.config/ygm/config.yml
+	ScopeLocal  Scope = "local"  // .ygm.yml
+)
+
+// Key describes a setting that can be read or written with `ygm config`.
+// Names may contain a single "*" segment matching an account slug.
+type Key struct {
+	Name        string
+	Scope       Scope
+	Description string
+	Secret      bool // Never printed unless explicitly requested
+	ReadOnly    bool // Managed by ygm itself (e.g. written by login)
+	Validate    func(value string) error
+}
+
+// Keys is the schema of every known setting
+var Keys = []Key{
+	{Name: "default_org", Scope: ScopeGlobal, Description: "Organization used when no other is selected"},
+	{Name: "api_url", Scope: ScopeGlobal, Description: "Default API URL for new logins", Validate: validateURL},
+	{Name: "accounts.*.api_url", Scope: ScopeGlobal, Description: "API URL for this account", Validate: validateURL},
+	{Name: "accounts.*.token", Scope: ScopeGlobal, Description: "API token for this account", Secret: true, ReadOnly: true},
+	{Name: "accounts.*.user_email", Scope: ScopeGlobal, Description: "Email of the logged-in user", ReadOnly: true},
+	{Name: "accounts.*.org_id", Scope: ScopeGlobal, Description: "Organization ID", ReadOnly: true},
+	{Name: "accounts.*.org_name", Scope: ScopeGlobal, Description: "Organization name", ReadOnly: true},
+	{Name: "org", Scope: ScopeLocal, Description: "Organization slug to use for this project"},
+	{Name: "tasks.defaults.platform", Scope: ScopeLocal, Description: "Default platform for 'ygm tasks create'"},
+	{Name: "tasks.defaults.asset_type", Scope: ScopeLocal, Description: "Default asset type for 'ygm tasks create'", Validate: oneOf(AssetTypes)},
+	{Name: "tasks.filters.status", Scope: ScopeLocal, Description: "Default status filter for 'ygm tasks'", Validate: oneOf(TaskStatuses)},
+	{Name: "tasks.filters.platform", Scope: ScopeLocal, Description: "Default platform filter for 'ygm tasks'"},
+	{Name: "context.sections", Scope: ScopeLocal, Description: "Comma-separated sections for 'ygm context'", Validate: listOf(ContextSections)},
+	{Name: "voice.addenda", Scope: ScopeLocal, Description: "Project-specific brand voice note (use 'ygm config edit --local' for several)"},
+	{Name: "hooks.types", Scope: ScopeLocal, Description: "Comma-separated commit types the git hooks suggest tasks for (default feat)"},
+	{Name: "hooks.trailers", Scope: ScopeLocal, Description: "Comma-separated commit trailers that mark a commit for a task, e.g. \"Marketing: yes\"", Validate: validateTrailers},
+}
+
+// LookupKey finds the schema entry for a key name
+func LookupKey(name string) (*Key, error) {
+	for i := range Keys {
+		if matchKey(Keys[i].Name, name) {
+			return &Keys[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown config key '%s'", name)
+}
+
+// matchKey reports whether name matches pattern, where a "*" segment in the
+// pattern matches any single segment
+func matchKey(pattern, name string) bool {
+	p := strings.Split(pattern, ".")
+	n := strings.Split(name, ".")
+	if len(p) != len(n) {
+		return false
+	}
+	for i := range p {
+		if p[i] != "*" && p[i] != n[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func oneOf(allowed []string) func(string) error {
+	return func(value string) error {
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("invalid value '%s' (expected one of: %s)", value, strings.Join(allowed, ", "))
+		}
+		return nil
+	}
+}
+
+func listOf(allowed []string) func(string) error {
+	return func(value string) error {
+		for _, item := range splitList(value) {
+			if err := oneOf(allowed)(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// splitList splits a comma-separated setting value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func validateURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("'%s' is not a valid http(s) URL", value)
+	}
+	return nil
+}
+
+// validateTrailers checks each item is a "Key: value" commit trailer
+func validateTrailers(value string) error {
+	for _, item := range splitList(value) {
+		key, val, ok := strings.Cut(item, ":")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(val) == "" || strings.ContainsAny(strings.TrimSpace(key), " \t") {
+			return fmt.Errorf("'%s' is not a trailer (expected \"Key: value\")", item)
+		}
+	}
+	return nil
+}
+
+// accountKey splits "accounts.<slug>.<field>" into slug and field
+func accountKey(name string) (slug, field string, ok bool) {
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 || parts[0] != "accounts" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// Settings returns every global setting that has a value, keyed by name
+func (c *Config) Settings() map[string]string {
+	settings := make(map[string]string)
+	if c.DefaultOrg != "" {
+		settings["default_org"] = c.DefaultOrg
+	}
+	if c.APIURL != "" {
+		settings["api_url"] = c.APIURL
+	}
+	for slug, account := range c.Accounts {
+		prefix := "accounts." + slug + "."
+		settings[prefix+"token"] = account.Token
+		settings[prefix+"user_email"] = account.UserEmail
+		settings[prefix+"org_id"] = strconv.Itoa(account.OrgID)
+		settings[prefix+"org_name"] = account.OrgName
+		settings[prefix+"api_url"] = c.AccountAPIURL(account)
+	}
+	return settings
+}
+
+// Get returns the value of a global setting and whether it is set
+func (c *Config) Get(name string) (string, bool) {
+	value, ok := c.Settings()[name]
+	return value, ok
+}
+
+// Set validates and stores a global setting
+func (c *Config) Set(name, value string) error {
+	key, err := c.writableKey(name)
+	if err != nil {
+		return err
+	}
+	if key.Validate != nil {
+		if err := key.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	switch key.Name {
+	case "default_org":
+		if _, ok := c.Accounts[value]; !ok {
+			return fmt.Errorf("organization '%s' not found. Available: %s", value, strings.Join(c.Slugs(), ", "))
+		}
+		c.DefaultOrg = value
+	case "api_url":
+		c.APIURL = value
+	case "accounts.*.api_url":
+		slug, _, _ := accountKey(name)
+		account := c.Accounts[slug]
+		account.APIURL = value
+		c.Accounts[slug] = account
+	}
+	return nil
+}
+
+// Unset clears a global setting, reverting it to its default
+func (c *Config) Unset(name string) error {
+	key, err := c.writableKey(name)
+	if err != nil {
+		return err
+	}
+
+	switch key.Name {
+	case "default_org":
+		c.DefaultOrg = ""
+	case "api_url":
+		c.APIURL = ""
+	case "accounts.*.api_url":
+		slug, _, _ := accountKey(name)
+		account := c.Accounts[slug]
+		account.APIURL = ""
+		c.Accounts[slug] = account
+	}
+	return nil
+}
+
+// writableKey looks up a global key and checks that it may be changed
+func (c *Config) writableKey(name string) (*Key, error) {
+	key, err := LookupKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if key.Scope != ScopeGlobal {
+		return nil, fmt.Errorf("'%s' is a %s setting", name, key.Scope)
+	}
+	if key.ReadOnly {
+		return nil, fmt.Errorf("'%s' is managed by 'ygm login' and can't be changed directly", name)
+	}
+	if slug, _, ok := accountKey(name); ok {
+		if _, exists := c.Accounts[slug]; !exists {
+			return nil, fmt.Errorf("organization '%s' not found in config", slug)
+		}
+	}
+	return key, nil
+}
+
+// Slugs returns the configured organization slugs in sorted order
+func (c *Config) Slugs() []string {
+	slugs := make([]string, 0, len(c.Accounts))
+	for slug := range c.Accounts {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	return slugs
+}
+
+// Settings returns every local setting that has a value, keyed by name.
+// Lists are joined with commas, except voice addenda which are free text
+// and joined with newlines.
+func (c *LocalConfig) Settings() map[string]string {
+	settings := make(map[string]string)
+	set := func(name, value string) {
+		if value != "" {
+			settings[name] = value
+		}
+	}
+	set("org", c.Org)
+	set("tasks.defaults.platform", c.Tasks.Defaults.Platform)
+	set("tasks.defaults.asset_type", c.Tasks.Defaults.AssetType)
+	set("tasks.filters.status", c.Tasks.Filters.Status)
+	set("tasks.filters.platform", c.Tasks.Filters.Platform)
+	set("context.sections", strings.Join(c.Context.Sections, ","))
+	set("voice.addenda", strings.Join(c.Voice.Addenda, "\n"))
+	set("hooks.types", strings.Join(c.Hooks.Types, ","))
+	set("hooks.trailers", strings.Join(c.Hooks.Trailers, ","))
+	return settings
+}
+
+// Get returns the value of a local setting and whether it is set
+func (c *LocalConfig) Get(name string) (string, bool) {
+	value, ok := c.Settings()[name]
+	return value, ok
+}
+
+// Set validates and stores a local setting
+func (c *LocalConfig) Set(name, value string) error {
+	key, err := localKey(name)
+	if err != nil {
+		return err
+	}
+	if key.Validate != nil {
+		if err := key.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	switch key.Name {
+	case "org":
+		c.Org = value
+	case "tasks.defaults.platform":
+		c.Tasks.Defaults.Platform = value
+	case "tasks.defaults.asset_type":
+		c.Tasks.Defaults.AssetType = value
+	case "tasks.filters.status":
+		c.Tasks.Filters.Status = value
+	case "tasks.filters.platform":
+		c.Tasks.Filters.Platform = value
+	case "context.sections":
+		c.Context.Sections = splitList(value)
+	case "voice.addenda":
+		c.Voice.Addenda = []string{value}
+	case "hooks.types":
+		c.Hooks.Types = splitList(value)
+	case "hooks.trailers":
+		c.Hooks.Trailers = splitList(value)
+	}
+	return nil
+}
+
+// Unset clears a local setting
+func (c *LocalConfig) Unset(name string) error {
+	key, err := localKey(name)
+	if err != nil {
+		return err
+	}
+
+	switch key.Name {
+	case "org":
+		c.Org = ""
+	case "tasks.defaults.platform":
+		c.Tasks.Defaults.Platform = ""
+	case "tasks.defaults.asset_type":
+		c.Tasks.Defaults.AssetType = ""
+	case "tasks.filters.status":
+		c.Tasks.Filters.Status = ""
+	case "tasks.filters.platform":
+		c.Tasks.Filters.Platform = ""
+	case "context.sections":
+		c.Context.Sections = nil
+	case "voice.addenda":
+		c.Voice.Addenda = nil
+	case "hooks.types":
+		c.Hooks.Types = nil
+	case "hooks.trailers":
+		c.Hooks.Trailers = nil
+	}
+	return nil
+}
+
+func localKey(name string) (*Key, error) {
+	key, err := LookupKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if key.Scope != ScopeLocal {
+		return nil, fmt.Errorf("'%s' is a %s setting", name, key.Scope)
+	}
+	return key, nil
+}
+
+// Validate checks the config for settings that ygm can't use
+func (c *Config) Validate() error {
+	if c.DefaultOrg != "" {
+		if _, ok := c.Accounts[c.DefaultOrg]; !ok {
+			return fmt.Errorf("default_org: organization '%s' not found in accounts", c.DefaultOrg)
+		}
+	}
+	if c.APIURL != "" {
+		if err := validateURL(c.APIURL); err != nil {
+			return fmt.Errorf("api_url: %w", err)
+		}
+	}
+	for _, slug := range c.Slugs() {
+		if apiURL := c.Accounts[slug].APIURL; apiURL != "" {
+			if err := validateURL(apiURL); err != nil {
+				return fmt.Errorf("accounts.%s.api_url: %w", slug, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Parse strictly decodes and validates global config data, rejecting
+// unknown keys. It is used to check hand-edited configs before saving.
+func Parse(data []byte) (*Config, error) {
+	var cfg Config
+	if err := decodeStrict(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ParseLocal validates local config data against the schema and decodes it.
+// path is only used in error messages.
+func ParseLocal(path string, data []byte) (*LocalConfig, error) {
+	if err := validateLocal(path, data); err != nil {
+		return nil, err
+	}
+	var cfg LocalConfig
+	if err := decodeStrict(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func decodeStrict(data []byte, v interface{}) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(v); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LocalConfig represents project-specific config stored in .ygm.yml
+//
+// Local configs are layered: every .ygm.yml from the current directory up to
+// the project root is merged, with files closer to the current directory
+// overriding their parents. The project root is the nearest directory whose
+// .ygm.yml has "root: true", or else the git root.
+type LocalConfig struct {
+	Org     string          `yaml:"org,omitempty"`  // Organization slug to use for this project
+	Root    bool            `yaml:"root,omitempty"` // Stop looking for parent configs here
+	Tasks   TaskSettings    `yaml:"tasks,omitempty"`
+	Context ContextSettings `yaml:"context,omitempty"`
+	Voice   VoiceSettings   `yaml:"voice,omitempty"`
+	Hooks   HookSettings    `yaml:"hooks,omitempty"`
+
+	path    string            // Innermost file this config was loaded from
+	files   []string          // Layers merged into this config, outermost first
+	sources map[string]string // Setting name -> file that provided it
+}
+
+// TaskSettings holds per-project defaults for the tasks commands
+type TaskSettings struct {
+	Defaults TaskDefaults `yaml:"defaults,omitempty"` // Used by 'ygm tasks create'
+	Filters  TaskFilters  `yaml:"filters,omitempty"`  // Used by 'ygm tasks'
+}
+
+// TaskDefaults are applied to new tasks when the flag isn't given
+type TaskDefaults struct {
+	Platform  string `yaml:"platform,omitempty"`
+	AssetType string `yaml:"asset_type,omitempty"`
+}
+
+// TaskFilters are applied when listing tasks unless overridden by flags
+type TaskFilters struct {
+	Status   string `yaml:"status,omitempty"`
+	Platform string `yaml:"platform,omitempty"`
+}
+
+// ContextSettings controls what 'ygm context' includes
+type ContextSettings struct {
+	Sections []string `yaml:"sections,omitempty"` // Empty means all sections
+}
+
+// VoiceSettings holds project-specific additions to the brand voice
+type VoiceSettings struct {
+	Addenda []string `yaml:"addenda,omitempty"`
+}
+
+// HookSettings controls which commits 'ygm hooks' turns into task
+// suggestions
+type HookSettings struct {
+	Types    []string `yaml:"types,omitempty"`    // Conventional commit types; empty means feat
+	Trailers []string `yaml:"trailers,omitempty"` // Trailers such as "Marketing: yes"
+}
+
+// LocalConfigPath returns the path to the nearest local config file
+// It walks up the directory tree to find .ygm.yml (like .git)
+func LocalConfigPath() (string, error) {
+	paths, err := LocalConfigPaths()
+	if err != nil || len(paths) == 0 {
+		return "", err
+	}
+	return paths[len(paths)-1], nil
+}
+
+// LocalConfigPaths returns every .ygm.yml layer that applies to the current
+// directory, outermost first. It stops at a config marked "root: true" or at
+// the git root. If no config exists inside the git repository, the nearest
+// one above it is used on its own.
+func LocalConfigPaths() ([]string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	aboveGitRoot := false
+	for {
+		path := filepath.Join(dir, LocalConfigFile)
+		if _, err := os.Stat(path); err == nil {
+			paths = append([]string{path}, paths...)
+			layer, err := LoadLocalFile(path)
+			if err != nil {
+				return nil, err
+			}
+			if layer.Root || aboveGitRoot {
+				return paths, nil
+			}
+		}
+
+		if isGitRoot(dir) {
+			if len(paths) > 0 {
+				return paths, nil
+			}
+			aboveGitRoot = true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			// Reached filesystem root
+			return paths, nil
+		}
+		dir = parent
+	}
+}
+
+// LocalRoot returns the project root directory: the nearest directory with a
+// .ygm.yml marked "root: true", else the git root, else the outermost
+// directory with a .ygm.yml, else the current directory
+func LocalRoot() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	outermost := ""
+	for dir := cwd; ; {
+		path := filepath.Join(dir, LocalConfigFile)
+		if _, err := os.Stat(path); err == nil {
+			layer, err := LoadLocalFile(path)
+			if err != nil {
+				return "", err
+			}
+			if layer.Root {
+				return dir, nil
+			}
+			outermost = dir
+		}
+
+		if isGitRoot(dir) {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	if outermost != "" {
+		return outermost, nil
+	}
+	return cwd, nil
+}
+
+// isGitRoot reports whether dir is the top of a git working tree. .git is a
+// file rather than a directory in worktrees and submodules.
+func isGitRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
+
+// LoadLocal reads and merges the local config layers for the current
+// directory. It returns nil if there are none.
+func LoadLocal() (*LocalConfig, error) {
+	paths, err := LocalConfigPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, nil // No local config
+	}
+
+	merged := make(map[string]interface{})
+	sources := make(map[string]string)
+	for _, path := range paths {
+		layer, err := readLocalLayer(path)
+		if err != nil {
+			return nil, err
+		}
+		mergeLayer(merged, layer, "", path, sources)
+	}
+
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge local config: %w", err)
+	}
+
+	var cfg LocalConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse local config: %w", err)
+	}
+	cfg.path = paths[len(paths)-1]
+	cfg.files = paths
+	cfg.sources = sources
+
+	return &cfg, nil
+}
+
+// LoadLocalFile reads a single local config file without merging parents
+func LoadLocalFile(path string) (*LocalConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local config: %w", err)
+	}
+
+	if err := validateLocal(path, data); err != nil {
+		return nil, err
+	}
+
+	var cfg LocalConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse local config %s: %w", path, err)
+	}
+	cfg.path = path
+	cfg.files = []string{path}
+
+	return &cfg, nil
+}
+
+// readLocalLayer reads a local config file as an untyped document for merging
+func readLocalLayer(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local config: %w", err)
+	}
+
+	if err := validateLocal(path, data); err != nil {
+		return nil, err
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse local config %s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// mergeLayer merges src into dst. Nested mappings are merged key by key;
+// any other value (including lists) replaces the parent's. sources records
+// which file each dotted setting name came from.
+func mergeLayer(dst, src map[string]interface{}, prefix, path string, sources map[string]string) {
+	for key, value := range src {
+		if key == "root" {
+			continue // Marker for this file only, never inherited
+		}
+		name := prefix + key
+
+		if child, ok := value.(map[string]interface{}); ok {
+			existing, ok := dst[key].(map[string]interface{})
+			if !ok {
+				existing = make(map[string]interface{})
+				dst[key] = existing
+			}
+			mergeLayer(existing, child, name+".", path, sources)
+			continue
+		}
+
+		dst[key] = value
+		sources[name] = path
+	}
+}
+
+// Path returns the file this config was loaded from (the innermost layer),
+// or "" for a config that hasn't been saved yet
+func (c *LocalConfig) Path() string {
+	return c.path
+}
+
+// Files returns the config files merged into this config, outermost first
+func (c *LocalConfig) Files() []string {
+	return c.files
+}
+
+// Source returns the file a setting came from, or "" if it isn't set
+func (c *LocalConfig) Source(name string) string {
+	return c.sources[name]
+}
+
+// SourceNames returns the names of all settings with a known source, sorted
+func (c *LocalConfig) SourceNames() []string {
+	names := make([]string, 0, len(c.sources))
+	for name := range c.sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Save writes the local config back to the file it was loaded from, or to
+// the current directory if it is new. Merged configs from LoadLocal can't be
+// saved, since that would copy inherited values into the innermost file;
+// modify a single layer from LoadLocalFile instead.
+func (c *LocalConfig) Save() error {
+	if len(c.files) > 1 {
+		return fmt.Errorf("can't save a merged local config; load %s on its own with LoadLocalFile", c.path)
+	}
+
+	path := c.path
+	if path == "" {
+		var err error
+		path, err = filepath.Abs(LocalConfigFile)
+		if err != nil {
+			return fmt.Errorf("failed to write local config: %w", err)
+		}
+	}
+
+	return c.SaveTo(path)
+}
+
+// SaveTo writes the local config to the given path
+func (c *LocalConfig) SaveTo(path string) error {
+	lock, err := LockFile(path)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	return c.saveTo(path)
+}
+
+// UpdateLocalFile runs a locked read-modify-write cycle on a single local
+// config file: it loads the file (or starts an empty config if there is
+// none), applies fn and saves the result. If nothing is left in the config,
+// the file is removed instead; check IsEmpty on the result to tell. Use it
+// instead of LoadLocalFile followed by SaveTo whenever other ygm processes
+// may be writing the file.
+func UpdateLocalFile(path string, fn func(*LocalConfig) error) (*LocalConfig, error) {
+	lock, err := LockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	c := &LocalConfig{}
+	if _, err := os.Stat(path); err == nil {
+		if c, err = LoadLocalFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := fn(c); err != nil {
+		return nil, err
+	}
+
+	if c.IsEmpty() {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to remove local config: %w", err)
+		}
+		return c, nil
+	}
+	if err := c.saveTo(path); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// IsEmpty reports whether the config sets nothing at all
+func (c *LocalConfig) IsEmpty() bool {
+	return c.Org == "" && !c.Root &&
+		c.Tasks == TaskSettings{} &&
+		len(c.Context.Sections) == 0 &&
+		len(c.Voice.Addenda) == 0 &&
+		len(c.Hooks.Types) == 0 && len(c.Hooks.Trailers) == 0
+}
+
+// saveTo writes the local config to path; the caller must hold its lock
+func (c *LocalConfig) saveTo(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to serialize local config: %w", err)
+	}
+
+	if err := WriteFileAtomic(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write local config: %w", err)
+	}
+	c.path = path
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Allowed values for enumerated local settings
+var (
+	TaskStatuses    = []string{"pending", "in_progress", "completed", "shared"}
+	AssetTypes      = []string{"image", "copy", "video"}
+	ContextSections = []string{"organization", "brand", "marketing_plan", "tasks"}
+)
+
+// schemaNode describes the expected shape of one node in .ygm.yml
+type schemaNode struct {
+	kind     yaml.Kind
+	tag      string // Expected scalar tag, e.g. "!!str" or "!!bool"
+	enum     []string
+	fields   map[string]*schemaNode // For mappings
+	elements *schemaNode            // For sequences
+}
+
+func str(enum ...string) *schemaNode {
+	return &schemaNode{kind: yaml.ScalarNode, tag: "!!str", enum: enum}
+}
+
+var localSchema = &schemaNode{kind: yaml.MappingNode, fields: map[string]*schemaNode{
+	"org":  str(),
+	"root": {kind: yaml.ScalarNode, tag: "!!bool"},
+	"tasks": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+		"defaults": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+			"platform":   str(),
+			"asset_type": str(AssetTypes...),
+		}},
+		"filters": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+			"status":   str(TaskStatuses...),
+			"platform": str(),
+		}},
+	}},
+	"context": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+		"sections": {kind: yaml.SequenceNode, elements: str(ContextSections...)},
+	}},
+	"voice": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+		"addenda": {kind: yaml.SequenceNode, elements: str()},
+	}},
+	"hooks": {kind: yaml.MappingNode, fields: map[string]*schemaNode{
+		"types":    {kind: yaml.SequenceNode, elements: str()},
+		"trailers": {kind: yaml.SequenceNode, elements: str()},
+	}},
+}}
+
+// SchemaError lists every problem found in a local config file, each with
+// the line it occurs on
+type SchemaError struct {
+	Path     string
+	Problems []SchemaProblem
+}
+
+// SchemaProblem is a single validation failure
+type SchemaProblem struct {
+	Line    int
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	lines := make([]string, 0, len(e.Problems)+1)
+	lines = append(lines, fmt.Sprintf("invalid %s:", e.Path))
+	for _, p := range e.Problems {
+		lines = append(lines, fmt.Sprintf("  %s:%d: %s", e.Path, p.Line, p.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateLocal checks local config data against the schema
+func validateLocal(path string, data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse local config %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return nil // Empty file
+	}
+
+	var problems []SchemaProblem
+	checkNode(doc.Content[0], localSchema, "", &problems)
+	if len(problems) > 0 {
+		sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+		return &SchemaError{Path: path, Problems: problems}
+	}
+	return nil
+}
+
+// checkNode validates node against schema, appending problems. name is the
+// dotted setting name used in messages.
+func checkNode(node *yaml.Node, schema *schemaNode, name string, problems *[]SchemaProblem) {
+	add := func(line int, format string, args ...interface{}) {
+		*problems = append(*problems, SchemaProblem{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+	label := name
+	if label == "" {
+		label = "top level"
+	}
+
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return // Explicitly empty, same as unset
+	}
+
+	if node.Kind != schema.kind {
+		add(node.Line, "%s must be %s", label, kindName(schema))
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			child, ok := schema.fields[key.Value]
+			if !ok {
+				add(key.Line, "unknown key '%s' in %s (expected one of: %s)", key.Value, label, strings.Join(fieldNames(schema), ", "))
+				continue
+			}
+			checkNode(value, child, joinName(name, key.Value), problems)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			checkNode(item, schema.elements, name+"[]", problems)
+		}
+	case yaml.ScalarNode:
+		if node.Tag != schema.tag {
+			add(node.Line, "%s must be %s", label, kindName(schema))
+			return
+		}
+		if len(schema.enum) > 0 && !slices.Contains(schema.enum, node.Value) {
+			add(node.Line, "invalid %s '%s' (expected one of: %s)", label, node.Value, strings.Join(schema.enum, ", "))
+		}
+	}
+}
+
+func kindName(schema *schemaNode) string {
+	switch schema.kind {
+	case yaml.MappingNode:
+		return "a mapping"
+	case yaml.SequenceNode:
+		return "a list"
+	}
+	if schema.tag == "!!bool" {
+		return "true or false"
+	}
+	return "a string"
+}
+
+func fieldNames(schema *schemaNode) []string {
+	names := make([]string, 0, len(schema.fields))
+	for name := range schema.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func joinName(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
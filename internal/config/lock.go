@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LockTimeout is how long to wait for another ygm process to release a
+// config lock before giving up
+var LockTimeout = 10 * time.Second
+
+// errLockBusy is returned by tryLock when another process holds the lock
+var errLockBusy = errors.New("lock is held by another process")
+
+// LockedError is returned when a config file stays locked past LockTimeout
+type LockedError struct {
+	Path string
+	PID  int
+}
+
+func (e *LockedError) Error() string {
+	if e.PID > 0 {
+		return fmt.Sprintf("config is locked by PID %d (%s)", e.PID, e.Path)
+	}
+	return fmt.Sprintf("config is locked by another ygm process (%s)", e.Path)
+}
+
+// FileLock is an advisory lock guarding read-modify-write cycles on a
+// config or state file. It is held on a separate <file>.lock next to it.
+type FileLock struct {
+	path string
+	file *os.File
+}
+
+// LockFile acquires the lock for the file at path, waiting up to LockTimeout
+func LockFile(path string) (*FileLock, error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(LockTimeout)
+
+	for {
+		f, err := tryLock(lockPath)
+		if err == nil {
+			// Record our PID so waiting processes can report who holds the lock
+			f.Truncate(0)
+			f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+			return &FileLock{path: lockPath, file: f}, nil
+		}
+		if !errors.Is(err, errLockBusy) {
+			return nil, fmt.Errorf("failed to lock config: %w", err)
+		}
+
+		if time.Now().After(deadline) {
+			return nil, &LockedError{Path: lockPath, PID: lockHolder(lockPath)}
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// Unlock releases the lock and removes the lock file
+func (l *FileLock) Unlock() error {
+	return unlock(l.path, l.file)
+}
+
+// lockHolder reads the PID recorded in a lock file, or 0 if unknown
+func lockHolder(lockPath string) int {
+	data, err := os.ReadFile(lockPath)
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+	return pid
+}
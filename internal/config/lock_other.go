@@ -0,0 +1,56 @@
+//go:build !unix
+
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// staleLockAge is how old a lock file must be before it is assumed to have
+// been left behind by a ygm process that crashed. Locks are only held for a
+// single read-modify-write cycle, so a live one is never this old.
+const staleLockAge = time.Minute
+
+// tryLock creates lockPath exclusively. Platforms without flock fall back to
+// the existence of the lock file itself as the lock, so a lock file left
+// behind by a crashed process is removed once it is older than staleLockAge.
+func tryLock(lockPath string) (*os.File, error) {
+	f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err == nil {
+		return f, nil
+	}
+	if !os.IsExist(err) {
+		return nil, err
+	}
+
+	if removeStaleLock(lockPath) {
+		return tryLock(lockPath)
+	}
+	return nil, errLockBusy
+}
+
+// removeStaleLock removes lockPath if it is older than staleLockAge,
+// reporting whether it did
+func removeStaleLock(lockPath string) bool {
+	info, err := os.Stat(lockPath)
+	if err != nil || time.Since(info.ModTime()) < staleLockAge {
+		return false
+	}
+
+	// Make sure another process hasn't already replaced it with a fresh lock
+	current, err := os.Stat(lockPath)
+	if err != nil || !os.SameFile(info, current) {
+		return false
+	}
+	return os.Remove(lockPath) == nil
+}
+
+// unlock closes the lock file before removing it, since open files can't
+// always be removed on these platforms
+func unlock(lockPath string, f *os.File) error {
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(lockPath)
+}
@@ -0,0 +1,53 @@
+//go:build unix
+
+package config
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// tryLock opens lockPath and takes a non-blocking exclusive flock on it
+func tryLock(lockPath string) (*os.File, error) {
+	f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, errLockBusy
+		}
+		return nil, err
+	}
+
+	// The previous holder may have removed the file between our open and
+	// flock, leaving us locking an orphaned inode. Retry in that case.
+	opened, err := f.Stat()
+	if err != nil {
+		release(f)
+		return nil, err
+	}
+	current, err := os.Stat(lockPath)
+	if err != nil || !os.SameFile(opened, current) {
+		release(f)
+		return nil, errLockBusy
+	}
+
+	return f, nil
+}
+
+// unlock removes the lock file and releases the flock. The file is removed
+// first so a waiter never keeps a lock on a file that is about to
+// disappear; tryLock detects and retries if it raced with us.
+func unlock(lockPath string, f *os.File) error {
+	os.Remove(lockPath)
+	return release(f)
+}
+
+func release(f *os.File) error {
+	defer f.Close()
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
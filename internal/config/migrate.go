@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// migration upgrades a raw config document from version from to from+1.
+// Migrations operate on the untyped YAML document so they keep working
+// after the Config struct has moved on.
+type migration struct {
+	from        int
+	description string
+	apply       func(doc map[string]interface{}) error
+}
+
+// migrations is the ordered list of upgrades, one per historical version.
+// Append to it (and bump ConfigVersion) whenever the config layout changes.
+var migrations = []migration{
+	{from: 0, description: "add version field", apply: migrateV0},
+	{from: 1, description: "move api_url into each account", apply: migrateV1},
+}
+
+// needsMigration reports whether config data read from path is older than
+// ConfigVersion, without changing anything. A newer version is an error.
+func needsMigration(path string, data []byte) (bool, error) {
+	_, version, err := parseVersioned(path, data)
+	if err != nil {
+		return false, err
+	}
+	return version < ConfigVersion, nil
+}
+
+// migrate upgrades config data read from path to ConfigVersion. It returns
+// nil if the data is already current. The original is backed up to
+// <path>.bak-<version>; the caller must hold the config lock and is
+// responsible for saving the result.
+func migrate(path string, data []byte) ([]byte, error) {
+	doc, version, err := parseVersioned(path, data)
+	if err != nil {
+		return nil, err
+	}
+	if version == ConfigVersion {
+		return nil, nil
+	}
+
+	for _, m := range migrations {
+		if m.from < version {
+			continue
+		}
+		if err := m.apply(doc); err != nil {
+			return nil, fmt.Errorf("failed to migrate config from version %d (%s): %w", m.from, m.description, err)
+		}
+		doc["version"] = m.from + 1
+	}
+
+	migrated, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize migrated config: %w", err)
+	}
+
+	backupPath := fmt.Sprintf("%s.bak-%d", path, version)
+	if _, err := os.Stat(backupPath); os.IsNotExist(err) {
+		if err := WriteFileAtomic(backupPath, data, 0600); err != nil {
+			return nil, fmt.Errorf("failed to back up config: %w", err)
+		}
+	}
+
+	return migrated, nil
+}
+
+// parseVersioned parses config data and its version, refusing versions
+// newer than this ygm understands
+func parseVersioned(path string, data []byte) (map[string]interface{}, int, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if doc == nil {
+		doc = make(map[string]interface{})
+	}
+
+	version, err := documentVersion(doc)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version > ConfigVersion {
+		return nil, 0, fmt.Errorf("config %s has version %d, but this ygm only supports up to version %d; upgrade ygm to use it",
+			path, version, ConfigVersion)
+	}
+	return doc, version, nil
+}
+
+// documentVersion reads the version field, treating a missing one as 0
+func documentVersion(doc map[string]interface{}) (int, error) {
+	raw, ok := doc["version"]
+	if !ok || raw == nil {
+		return 0, nil
+	}
+	version, ok := raw.(int)
+	if !ok || version < 0 {
+		return 0, fmt.Errorf("config has invalid version %v", raw)
+	}
+	return version, nil
+}
+
+// migrateV0 handles configs written before the version field existed.
+// Their layout matches version 1.
+func migrateV0(doc map[string]interface{}) error {
+	if _, ok := doc["api_url"]; !ok {
+		doc["api_url"] = DefaultAPIURL
+	}
+	return nil
+}
+
+// migrateV1 copies the global api_url into every account that doesn't have
+// its own, so later logins against other environments don't affect them
+func migrateV1(doc map[string]interface{}) error {
+	apiURL, _ := doc["api_url"].(string)
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+		doc["api_url"] = apiURL
+	}
+
+	accounts, ok := doc["accounts"].(map[string]interface{})
+	if !ok {
+		if doc["accounts"] != nil {
+			return fmt.Errorf("accounts is not a mapping")
+		}
+		return nil
+	}
+
+	for slug, raw := range accounts {
+		account, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("account '%s' is not a mapping", slug)
+		}
+		if url, _ := account["api_url"].(string); url == "" {
+			account["api_url"] = apiURL
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useConfigFixture points ConfigPath at a temporary directory and copies a
+// fixture from testdata/migrate there. It returns the config path and the
+// fixture's contents.
+func useConfigFixture(t *testing.T, fixture string) (string, []byte) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir) // Linux and BSDs
+	t.Setenv("HOME", dir)            // macOS
+	t.Setenv("AppData", dir)         // Windows
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join("testdata", "migrate", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, data
+}
+
+func TestLoadMigratesV0(t *testing.T) {
+	path, original := useConfigFixture(t, "v0.yml")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if cfg.Version != ConfigVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, ConfigVersion)
+	}
+	if cfg.APIURL != DefaultAPIURL {
+		t.Errorf("APIURL = %q, want %q", cfg.APIURL, DefaultAPIURL)
+	}
+	acme := cfg.Accounts["acme"]
+	if acme.Token != "ygm_acme" || acme.OrgID != 1 {
+		t.Errorf("account not preserved: %+v", acme)
+	}
+	if acme.APIURL != DefaultAPIURL {
+		t.Errorf("account APIURL = %q, want %q", acme.APIURL, DefaultAPIURL)
+	}
+
+	assertBackup(t, path+".bak-0", original)
+	assertSavedVersion(t, path)
+}
+
+func TestLoadMigratesV1(t *testing.T) {
+	path, original := useConfigFixture(t, "v1.yml")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if cfg.Version != ConfigVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, ConfigVersion)
+	}
+	tests := []struct {
+		slug string
+		want string
+	}{
+		{"acme", "https://staging.example.test"}, // Copied from the global api_url
+		{"local", "http://localhost:3000"},       // Already had its own
+	}
+	for _, tt := range tests {
+		if got := cfg.Accounts[tt.slug].APIURL; got != tt.want {
+			t.Errorf("account %s APIURL = %q, want %q", tt.slug, got, tt.want)
+		}
+	}
+
+	assertBackup(t, path+".bak-1", original)
+	assertSavedVersion(t, path)
+}
+
+func TestLoadCurrentVersionIsNotRewritten(t *testing.T) {
+	path, original := useConfigFixture(t, "v2.yml")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := cfg.Accounts["acme"].APIURL; got != "https://staging.example.test" {
+		t.Errorf("account APIURL = %q", got)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, original) {
+		t.Errorf("current config was rewritten:\n%s", data)
+	}
+	backups, _ := filepath.Glob(path + ".bak-*")
+	if len(backups) > 0 {
+		t.Errorf("unexpected backups: %v", backups)
+	}
+}
+
+func TestLoadFutureVersionFails(t *testing.T) {
+	path, original := useConfigFixture(t, "future.yml")
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("Load succeeded, want an error")
+	}
+	for _, want := range []string{"version 99", "upgrade ygm"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't mention %q", err, want)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, original) {
+		t.Errorf("newer config was modified:\n%s", data)
+	}
+}
+
+func TestMigrateBacksUpBeforeRewriting(t *testing.T) {
+	path, original := useConfigFixture(t, "v0.yml")
+
+	// migrate writes the backup itself; the caller saves the result later
+	migrated, err := migrate(path, original)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if migrated == nil {
+		t.Fatal("migrate returned nil for a version 0 config")
+	}
+	assertBackup(t, path+".bak-0", original)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, original) {
+		t.Error("migrate modified the config; only Load should save it")
+	}
+
+	// An existing backup is never overwritten by a later migration
+	if err := os.WriteFile(path+".bak-0", []byte("older backup"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	assertBackup(t, path+".bak-0", []byte("older backup"))
+	assertSavedVersion(t, path)
+}
+
+func assertBackup(t *testing.T, path string, want []byte) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("backup %s =\n%s\nwant\n%s", filepath.Base(path), data, want)
+	}
+}
+
+// assertSavedVersion checks the migrated config was written back to disk
+func assertSavedVersion(t *testing.T, path string) {
+	t.Helper()
+	cfg, outdated, err := readConfig(path, false)
+	if err != nil {
+		t.Fatalf("re-reading config: %v", err)
+	}
+	if outdated {
+		t.Fatal("config on disk still needs migrating")
+	}
+	if cfg.Version != ConfigVersion {
+		t.Errorf("saved Version = %d, want %d", cfg.Version, ConfigVersion)
+	}
+}
@@ -0,0 +1,181 @@
+package hooks
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/release"
+)
+
+// DefaultTypes are the Conventional Commit types suggested when none are
+// configured
+var DefaultTypes = []string{"feat"}
+
+// DefaultTrailers mark a commit for a suggestion when none are configured
+var DefaultTrailers = []string{"Marketing: yes"}
+
+// optOutValues in a watched trailer keep a commit out even if its type
+// matches, e.g. "Marketing: no"
+var optOutValues = []string{"no", "false", "skip", "none"}
+
+// Rules decide which commits are marketing-relevant
+type Rules struct {
+	Types    []string `json:"types"`    // Conventional Commit types, e.g. feat
+	Trailers []string `json:"trailers"` // "Key: value" trailers, matched case-insensitively
+}
+
+// NewRules returns rules from the configured types and trailers, using the
+// defaults for either one left empty
+func NewRules(types, trailers []string) Rules {
+	r := Rules{Types: types, Trailers: trailers}
+	if len(r.Types) == 0 {
+		r.Types = DefaultTypes
+	}
+	if len(r.Trailers) == 0 {
+		r.Trailers = DefaultTrailers
+	}
+	return r
+}
+
+// Commit is a commit examined by the hooks
+type Commit struct {
+	release.Commit
+	Body     string      // Message body without the trailers
+	Trailers [][2]string // Key and value pairs
+}
+
+// Match reports whether c is marketing-relevant and why. A watched trailer
+// set to "no" wins over everything else.
+func (r Rules) Match(c Commit) (string, bool) {
+	reason := ""
+	for _, want := range r.Trailers {
+		wantKey, wantValue, _ := strings.Cut(want, ":")
+		wantKey, wantValue = strings.TrimSpace(wantKey), strings.TrimSpace(wantValue)
+		for _, t := range c.Trailers {
+			if !strings.EqualFold(t[0], wantKey) {
+				continue
+			}
+			switch {
+			case isOptOut(t[1]):
+				return "", false
+			case strings.EqualFold(t[1], wantValue) && reason == "":
+				reason = t[0] + ": " + t[1]
+			}
+		}
+	}
+	if reason != "" {
+		return reason, true
+	}
+	for _, typ := range r.Types {
+		if strings.EqualFold(c.Type, typ) {
+			return c.Type + " commit", true
+		}
+	}
+	return "", false
+}
+
+func isOptOut(value string) bool {
+	for _, v := range optOutValues {
+		if strings.EqualFold(strings.TrimSpace(value), v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadCommits returns the non-merge commits git log selects with revs in
+// the repository containing dir, newest first
+func ReadCommits(dir string, revs ...string) ([]Commit, error) {
+	format := "--format=%H" + release.FieldSep + "%s" + release.FieldSep + "%b" + release.FieldSep + "%(trailers:only,unfold)" + release.RecordSep
+	out, err := release.Git(dir, append([]string{"log", "--no-merges", format}, revs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(out, release.RecordSep) {
+		fields := strings.SplitN(strings.TrimSpace(record), release.FieldSep, 4)
+		if len(fields) < 4 {
+			continue
+		}
+		trailers := parseTrailers(fields[3])
+		body := stripTrailers(fields[2], fields[3])
+		commits = append(commits, Commit{
+			Commit:   release.ParseCommit(fields[0], fields[1], body),
+			Body:     body,
+			Trailers: trailers,
+		})
+	}
+	return commits, nil
+}
+
+// parseTrailers parses git's "Key: value" trailer lines
+func parseTrailers(text string) [][2]string {
+	var trailers [][2]string
+	for _, line := range strings.Split(text, "\n") {
+		if key, value, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(key) != "" {
+			trailers = append(trailers, [2]string{strings.TrimSpace(key), strings.TrimSpace(value)})
+		}
+	}
+	return trailers
+}
+
+// stripTrailers removes the trailer block, which git only recognises as
+// the body's last paragraph
+func stripTrailers(body, trailers string) string {
+	body = strings.TrimSpace(body)
+	if strings.TrimSpace(trailers) == "" {
+		return body
+	}
+	if i := strings.LastIndex(body, "\n\n"); i >= 0 {
+		return strings.TrimSpace(body[:i])
+	}
+	return ""
+}
+
+// zeroSHA is what git sends for a ref that doesn't exist on one side
+const zeroSHA = "0000000000000000000000000000000000000000"
+
+// PushedCommits reads the refs a pre-push hook gets on stdin and returns
+// the commits being pushed that the remote doesn't have yet. Deleted refs
+// are ignored.
+func PushedCommits(dir string, stdin io.Reader) ([]Commit, error) {
+	var commits []Commit
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		// <local ref> <local sha> <remote ref> <remote sha>
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 4 || isZero(fields[1]) {
+			continue
+		}
+		local, remote := fields[1], fields[3]
+
+		revs := []string{local, "--not", "--remotes"}
+		if !isZero(remote) {
+			if _, err := release.Git(dir, "cat-file", "-e", remote+"^{commit}"); err == nil {
+				revs = []string{local, "^" + remote}
+			}
+		}
+
+		found, err := ReadCommits(dir, revs...)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range found {
+			if !seen[c.Hash] {
+				seen[c.Hash] = true
+				commits = append(commits, c)
+			}
+		}
+	}
+	return commits, scanner.Err()
+}
+
+// isZero reports whether sha is git's all-zero object name, in either the
+// SHA-1 or SHA-256 length
+func isZero(sha string) bool {
+	return strings.Trim(sha, "0") == "" && len(sha) >= len(zeroSHA)
+}
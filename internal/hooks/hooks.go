@@ -0,0 +1,172 @@
+// Package hooks installs git hooks that watch for marketing-relevant commits
+// and queues suggested tasks for them in the repository, to be reviewed with
+// 'ygm tasks suggestions'.
+package hooks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/release"
+)
+
+// Supported are the git hooks ygm can install
+var Supported = []string{"post-commit", "pre-push"}
+
+// marker identifies hook scripts written by ygm
+const marker = "# Installed by ygm"
+
+// chainedSuffix is appended to a hook that was already installed when ygm
+// took its place. The ygm hook runs it first, and uninstalling restores it.
+const chainedSuffix = ".ygm-chained"
+
+// Status describes one hook in a repository
+type Status struct {
+	Hook      string `json:"hook"`
+	Path      string `json:"path"`
+	Installed bool   `json:"installed"`         // The hook is ygm's
+	Foreign   bool   `json:"foreign,omitempty"` // Another hook is there instead
+	Chained   string `json:"chained,omitempty"` // Previous hook run by ygm's
+}
+
+// Dir returns the hooks directory of the git repository containing dir,
+// honouring core.hooksPath
+func Dir(dir string) (string, error) {
+	path, err := release.Git(dir, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository: %w", err)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return filepath.Abs(path)
+}
+
+// Check reports the state of hook in hooksDir
+func Check(hooksDir, hook string) (Status, error) {
+	s := Status{Hook: hook, Path: filepath.Join(hooksDir, hook)}
+
+	data, err := os.ReadFile(s.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return s, fmt.Errorf("failed to read %s hook: %w", hook, err)
+	case bytes.Contains(data, []byte(marker)):
+		s.Installed = true
+	default:
+		s.Foreign = true
+	}
+
+	if _, err := os.Stat(s.Path + chainedSuffix); err == nil {
+		s.Chained = s.Path + chainedSuffix
+	}
+	return s, nil
+}
+
+// Install writes ygm's hook to hooksDir, running executable to queue
+// suggestions. An existing hook is kept and chained rather than replaced.
+// Installing over ygm's own hook updates it.
+func Install(hooksDir, hook, executable string) (Status, error) {
+	if !slices.Contains(Supported, hook) {
+		return Status{}, fmt.Errorf("unsupported hook %q (expected one of: %s)", hook, strings.Join(Supported, ", "))
+	}
+
+	s, err := Check(hooksDir, hook)
+	if err != nil {
+		return s, err
+	}
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return s, fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	if s.Foreign {
+		if s.Chained != "" {
+			return s, fmt.Errorf("%s exists and so does %s; remove one of them first", s.Path, s.Chained)
+		}
+		if err := os.Rename(s.Path, s.Path+chainedSuffix); err != nil {
+			return s, fmt.Errorf("failed to chain existing %s hook: %w", hook, err)
+		}
+		s.Chained = s.Path + chainedSuffix
+		s.Foreign = false
+	}
+
+	if err := os.WriteFile(s.Path, []byte(script(hook, executable)), 0755); err != nil {
+		return s, fmt.Errorf("failed to write %s hook: %w", hook, err)
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(s.Path, 0755); err != nil {
+		return s, fmt.Errorf("failed to make %s hook executable: %w", hook, err)
+	}
+	s.Installed = true
+	return s, nil
+}
+
+// Uninstall removes ygm's hook from hooksDir and puts back the hook it
+// chained, if any. Hooks ygm didn't install are left alone.
+func Uninstall(hooksDir, hook string) (Status, error) {
+	s, err := Check(hooksDir, hook)
+	if err != nil {
+		return s, err
+	}
+	if s.Foreign {
+		return s, fmt.Errorf("the %s hook wasn't installed by ygm; leaving it alone", hook)
+	}
+	if !s.Installed {
+		return s, nil
+	}
+
+	if err := os.Remove(s.Path); err != nil {
+		return s, fmt.Errorf("failed to remove %s hook: %w", hook, err)
+	}
+	s.Installed = false
+
+	if s.Chained != "" {
+		if err := os.Rename(s.Chained, s.Path); err != nil {
+			return s, fmt.Errorf("failed to restore previous %s hook: %w", hook, err)
+		}
+		s.Chained = ""
+		s.Foreign = true
+	}
+	return s, nil
+}
+
+// script is the hook ygm installs. The chained hook runs first so a failing
+// pre-push check still stops the push; ygm itself never fails the hook.
+func script(hook, executable string) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	b.WriteString(marker + ": queues task suggestions for marketing-relevant commits.\n")
+	b.WriteString("# Remove it with 'ygm hooks uninstall'; any hook it replaced is restored.\n\n")
+	fmt.Fprintf(&b, "chained=\"$(dirname \"$0\")/%s%s\"\n", hook, chainedSuffix)
+	fmt.Fprintf(&b, "ygm=%s\n", shellQuote(executable))
+	b.WriteString("[ -x \"$ygm\" ] || ygm=ygm\n\n")
+
+	if hook == "pre-push" {
+		// Git passes the pushed refs on stdin, which both hooks need
+		b.WriteString("input=$(cat)\n")
+		b.WriteString("if [ -x \"$chained\" ]; then\n")
+		b.WriteString("\tprintf '%s\\n' \"$input\" | \"$chained\" \"$@\" || exit $?\n")
+		b.WriteString("fi\n")
+		b.WriteString("if command -v \"$ygm\" >/dev/null 2>&1; then\n")
+		fmt.Fprintf(&b, "\tprintf '%%s\\n' \"$input\" | \"$ygm\" hooks run %s \"$@\" || true\n", hook)
+		b.WriteString("fi\n")
+	} else {
+		b.WriteString("if [ -x \"$chained\" ]; then\n")
+		b.WriteString("\t\"$chained\" \"$@\" || exit $?\n")
+		b.WriteString("fi\n")
+		b.WriteString("if command -v \"$ygm\" >/dev/null 2>&1; then\n")
+		fmt.Fprintf(&b, "\t\"$ygm\" hooks run %s \"$@\" </dev/null || true\n", hook)
+		b.WriteString("fi\n")
+	}
+	return b.String()
+}
+
+// shellQuote quotes s for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
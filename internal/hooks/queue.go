@@ -0,0 +1,179 @@
+package hooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"github.com/CromulentConsulting/ygm-cli/internal/release"
+)
+
+// Suggestion is a task proposed for a marketing-relevant commit, waiting to
+// be reviewed
+type Suggestion struct {
+	Commit      string    `json:"commit"`
+	Subject     string    `json:"subject"`
+	Reason      string    `json:"reason"` // Why the commit was picked up
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Platform    string    `json:"platform,omitempty"`
+	AssetType   string    `json:"asset_type,omitempty"`
+	Date        string    `json:"suggested_post_date,omitempty"`
+	QueuedAt    time.Time `json:"queued_at"`
+}
+
+// Suggest proposes a task for a commit
+func Suggest(c Commit, reason string) Suggestion {
+	s := Suggestion{
+		Commit:   c.Hash,
+		Subject:  c.Subject,
+		Reason:   reason,
+		Title:    "Announce: " + release.Capitalize(strings.TrimSpace(c.Description)),
+		QueuedAt: time.Now().UTC(),
+	}
+	if c.Body != "" {
+		s.Description = release.Capitalize(strings.TrimSpace(c.Description)) + "\n\n" + c.Body
+	} else {
+		s.Description = release.Capitalize(strings.TrimSpace(c.Description))
+	}
+	return s
+}
+
+// Queue is the list of suggestions for a repository, kept in its git
+// directory so it's shared by worktrees and never committed
+type Queue struct {
+	Path        string       `json:"-"`
+	Suggestions []Suggestion `json:"suggestions"`
+	Handled     []string     `json:"handled,omitempty"` // Commits already created or dropped
+}
+
+// LoadQueue reads the queue of the git repository containing dir. A missing
+// queue is empty. Use UpdateQueue to change it.
+func LoadQueue(dir string) (*Queue, error) {
+	path, err := queuePath(dir)
+	if err != nil {
+		return nil, err
+	}
+	return readQueue(path)
+}
+
+// UpdateQueue loads the queue of the git repository containing dir, applies
+// fn and saves the result, holding the queue's lock throughout so that
+// concurrent hooks and commands don't lose each other's changes. Nothing is
+// saved if fn fails.
+func UpdateQueue(dir string, fn func(*Queue) error) (*Queue, error) {
+	path, err := queuePath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	lock, err := config.LockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	q, err := readQueue(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(q); err != nil {
+		return nil, err
+	}
+	if err := q.save(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// queuePath returns where the queue of the git repository containing dir
+// is kept
+func queuePath(dir string) (string, error) {
+	gitDir, err := release.Git(dir, "rev-parse", "--git-common-dir")
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository: %w", err)
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(dir, gitDir)
+	}
+	return filepath.Join(gitDir, "ygm", "suggestions.json"), nil
+}
+
+func readQueue(path string) (*Queue, error) {
+	q := &Queue{Path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read suggestions: %w", err)
+	}
+	if err := json.Unmarshal(data, q); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return q, nil
+}
+
+// save writes the queue atomically. The caller holds its lock.
+func (q *Queue) save() error {
+	if q.Suggestions == nil {
+		q.Suggestions = []Suggestion{}
+	}
+	data, err := json.MarshalIndent(q, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode suggestions: %w", err)
+	}
+	if err := config.WriteFileAtomic(q.Path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to save suggestions: %w", err)
+	}
+	return nil
+}
+
+// Add queues s unless its commit is already queued or handled, and reports
+// whether it was added. A suggestion with the same subject is moved to the
+// new commit instead, so amending or rebasing a commit doesn't queue it
+// twice.
+func (q *Queue) Add(s Suggestion) bool {
+	if slices.Contains(q.Handled, s.Commit) {
+		return false
+	}
+	for i, existing := range q.Suggestions {
+		if existing.Commit == s.Commit {
+			return false
+		}
+		if existing.Subject == s.Subject {
+			q.Suggestions[i].Commit = s.Commit
+			return false
+		}
+	}
+	q.Suggestions = append(q.Suggestions, s)
+	return true
+}
+
+// Remove drops the suggestions for the given commits. The commits are
+// remembered so that the pre-push hook doesn't queue them again.
+func (q *Queue) Remove(commits ...string) {
+	drop := make(map[string]bool, len(commits))
+	for _, hash := range commits {
+		drop[hash] = true
+		if !slices.Contains(q.Handled, hash) {
+			q.Handled = append(q.Handled, hash)
+		}
+	}
+	kept := q.Suggestions[:0]
+	for _, s := range q.Suggestions {
+		if !drop[s.Commit] {
+			kept = append(kept, s)
+		}
+	}
+	q.Suggestions = kept
+}
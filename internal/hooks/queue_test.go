@@ -0,0 +1,103 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func initRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Skipf("git init: %v: %s", err, out)
+	}
+	return dir
+}
+
+// TestUpdateQueueConcurrent checks concurrent hook runs don't lose each
+// other's suggestions
+func TestUpdateQueueConcurrent(t *testing.T) {
+	dir := initRepo(t)
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := UpdateQueue(dir, func(q *Queue) error {
+				q.Add(Suggestion{Commit: fmt.Sprintf("c%02d", i), Subject: fmt.Sprintf("feat: %d", i)})
+				return nil
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	q, err := LoadQueue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Suggestions) != n {
+		t.Errorf("queue has %d suggestions, want %d", len(q.Suggestions), n)
+	}
+	if _, err := os.Stat(q.Path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file left behind: %v", err)
+	}
+}
+
+func TestUpdateQueueFailureSavesNothing(t *testing.T) {
+	dir := initRepo(t)
+
+	_, err := UpdateQueue(dir, func(q *Queue) error {
+		q.Add(Suggestion{Commit: "c1", Subject: "feat: one"})
+		return fmt.Errorf("boom")
+	})
+	if err == nil {
+		t.Fatal("UpdateQueue succeeded, want the callback's error")
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".git", "ygm", "suggestions.json")); !os.IsNotExist(err) {
+		t.Errorf("queue was saved: %v", err)
+	}
+}
+
+func TestQueueAddAndRemove(t *testing.T) {
+	q := &Queue{}
+	if !q.Add(Suggestion{Commit: "c1", Subject: "feat: one"}) {
+		t.Fatal("first Add returned false")
+	}
+	if q.Add(Suggestion{Commit: "c1", Subject: "feat: one"}) {
+		t.Error("same commit queued twice")
+	}
+	// An amended commit keeps its subject and moves the suggestion
+	if q.Add(Suggestion{Commit: "c1b", Subject: "feat: one"}) {
+		t.Error("amended commit queued as a new suggestion")
+	}
+	if got := q.Suggestions[0].Commit; got != "c1b" {
+		t.Errorf("suggestion commit = %q, want c1b", got)
+	}
+
+	q.Add(Suggestion{Commit: "c2", Subject: "feat: two"})
+	q.Remove("c1b")
+	q.Remove("c1b")
+	if len(q.Suggestions) != 1 || q.Suggestions[0].Commit != "c2" {
+		t.Errorf("Suggestions = %+v, want only c2", q.Suggestions)
+	}
+	if len(q.Handled) != 1 || q.Handled[0] != "c1b" {
+		t.Errorf("Handled = %v, want [c1b]", q.Handled)
+	}
+	if q.Add(Suggestion{Commit: "c1b", Subject: "feat: one"}) {
+		t.Error("removed commit queued again")
+	}
+}
@@ -0,0 +1,129 @@
+package release
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+// DefaultPlatforms are announced on when no platforms are given
+var DefaultPlatforms = []string{"blog", "twitter", "linkedin"}
+
+// platformPlan is how a release is announced on one platform
+type platformPlan struct {
+	Name   string // Display name used in task titles
+	Offset int    // Days after the first post date
+	Draft  func(r *Release) string
+}
+
+// platformPlans are the platforms with their own announcement templates.
+// Others get the LinkedIn-style draft, posted on the second day.
+var platformPlans = map[string]platformPlan{
+	"blog":     {Name: "the blog", Offset: 0, Draft: blogDraft},
+	"twitter":  {Name: "Twitter", Offset: 0, Draft: shortDraft},
+	"x":        {Name: "X", Offset: 0, Draft: shortDraft},
+	"linkedin": {Name: "LinkedIn", Offset: 1, Draft: longDraft},
+	"reddit":   {Name: "Reddit", Offset: 2, Draft: longDraft},
+	"email":    {Name: "the newsletter", Offset: 3, Draft: blogDraft},
+}
+
+// tweetLimit is the length a short draft must fit in
+const tweetLimit = 280
+
+// Drafts proposes a launch task per platform, with post dates counted from
+// start
+func Drafts(r *Release, platforms []string, start time.Time) []api.CreateTaskRequest {
+	drafts := make([]api.CreateTaskRequest, 0, len(platforms))
+	for _, platform := range platforms {
+		plan, ok := platformPlans[platform]
+		if !ok {
+			plan = platformPlan{Name: Capitalize(platform), Offset: 1, Draft: longDraft}
+		}
+		date := start.AddDate(0, 0, plan.Offset).Format("2006-01-02")
+		drafts = append(drafts, api.CreateTaskRequest{
+			Title:             fmt.Sprintf("Announce %s %s on %s", r.Project, r.Tag, plan.Name),
+			Description:       plan.Draft(r),
+			Platform:          platform,
+			AssetType:         "copy",
+			SuggestedPostDate: &date,
+		})
+	}
+	return drafts
+}
+
+// shortDraft fits the headline and as many highlights as possible in a
+// tweet
+func shortDraft(r *Release) string {
+	text := fmt.Sprintf("%s %s is out!", r.Project, r.Tag)
+	for _, h := range r.Highlights(3) {
+		line := "\n• " + h
+		if len([]rune(text+line)) > tweetLimit {
+			break
+		}
+		text += line
+	}
+	return text
+}
+
+// longDraft is a few paragraphs for LinkedIn-style posts
+func longDraft(r *Release) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "We just released %s %s.\n", r.Project, r.Tag)
+
+	if highlights := r.Highlights(5); len(highlights) > 0 {
+		b.WriteString("\nWhat's new:\n")
+		for _, h := range highlights {
+			fmt.Fprintf(&b, "- %s\n", h)
+		}
+	}
+
+	// Mention fixes that weren't highlighted themselves
+	notes := r.Notes()
+	highlightedFixes := r.Changelog != "" || len(notes.Breaking)+len(notes.Features)+len(notes.Performance)+len(notes.Other) == 0
+	if n := len(notes.Fixes); n > 0 && !highlightedFixes {
+		if n == 1 {
+			b.WriteString("\nPlus 1 bug fix.\n")
+		} else {
+			fmt.Fprintf(&b, "\nPlus %d bug fixes.\n", n)
+		}
+	}
+	if len(notes.Breaking) > 0 {
+		b.WriteString("\nHeads up: this release has breaking changes, see the release notes before upgrading.\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// blogDraft is the full release notes: the changelog entry if there is one,
+// otherwise the commits grouped by type
+func blogDraft(r *Release) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Release notes for %s %s", r.Project, r.Tag)
+	if r.PreviousTag != "" {
+		fmt.Fprintf(&b, " (changes since %s)", r.PreviousTag)
+	}
+	b.WriteString(".\n")
+
+	if r.Changelog != "" {
+		b.WriteString("\n" + r.Changelog + "\n")
+		return strings.TrimSpace(b.String())
+	}
+
+	notes := r.Notes()
+	section := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", title)
+		for _, item := range items {
+			fmt.Fprintf(&b, "- %s\n", item)
+		}
+	}
+	section("Breaking changes", notes.Breaking)
+	section("Features", notes.Features)
+	section("Performance", notes.Performance)
+	section("Fixes", notes.Fixes)
+	section("Other changes", notes.Other)
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,127 @@
+// Package release reads a release from the local git repository (its tag,
+// the commits since the previous tag and its changelog entry) and drafts
+// launch tasks announcing it.
+package release
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Release is a tagged version and what changed since the previous one
+type Release struct {
+	Project     string    // Repository name, used in announcements
+	Tag         string    // e.g. v1.2.0
+	PreviousTag string    // Empty for the first release
+	Date        time.Time // When the tagged commit was made
+	Commits     []Commit  // Non-merge commits since PreviousTag, newest first
+	Changelog   string    // Matching CHANGELOG.md section, without its heading
+	Root        string    // Repository root directory
+}
+
+// Read loads a release from the git repository containing dir. An empty tag
+// means the most recent tag reachable from HEAD.
+func Read(dir, tag string) (*Release, error) {
+	root, err := Git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, fmt.Errorf("not in a git repository: %w", err)
+	}
+
+	if tag == "" {
+		tag, err = Git(root, "describe", "--tags", "--abbrev=0")
+		if err != nil {
+			return nil, errors.New("no tags found; create one with 'git tag' or name it explicitly")
+		}
+	} else if _, err := Git(root, "rev-parse", "--verify", "--quiet", "refs/tags/"+tag); err != nil {
+		return nil, fmt.Errorf("tag %s not found", tag)
+	}
+
+	r := &Release{Project: filepath.Base(root), Tag: tag, Root: root}
+
+	// The previous tag is the nearest one reachable from the tag's parent
+	if prev, err := Git(root, "describe", "--tags", "--abbrev=0", tag+"^"); err == nil {
+		r.PreviousTag = prev
+	}
+
+	date, err := Git(root, "log", "-1", "--format=%cI", tag)
+	if err != nil {
+		return nil, err
+	}
+	if r.Date, err = time.Parse(time.RFC3339, date); err != nil {
+		return nil, fmt.Errorf("unexpected commit date %q", date)
+	}
+
+	if r.Commits, err = readCommits(root, r.PreviousTag, tag); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ReadChangelog fills in the release's section of a changelog file. A
+// missing file isn't an error; the announcement falls back to the commits.
+func (r *Release) ReadChangelog(path string) error {
+	if path == "" {
+		path = filepath.Join(r.Root, "CHANGELOG.md")
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read changelog: %w", err)
+	}
+	r.Changelog = ChangelogSection(string(data), r.Tag)
+	return nil
+}
+
+// Separators for 'git log' output that can't appear in commit messages
+const (
+	FieldSep  = "\x1f"
+	RecordSep = "\x1e"
+)
+
+func readCommits(root, from, to string) ([]Commit, error) {
+	rangeSpec := to
+	if from != "" {
+		rangeSpec = from + ".." + to
+	}
+	out, err := Git(root, "log", "--no-merges", "--format=%H"+FieldSep+"%s"+FieldSep+"%b"+RecordSep, rangeSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(out, RecordSep) {
+		fields := strings.SplitN(strings.TrimSpace(record), FieldSep, 3)
+		if len(fields) < 2 {
+			continue
+		}
+		body := ""
+		if len(fields) == 3 {
+			body = strings.TrimSpace(fields[2])
+		}
+		commits = append(commits, ParseCommit(fields[0], fields[1], body))
+	}
+	return commits, nil
+}
+
+// Git runs a git command in dir and returns its trimmed output
+func Git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
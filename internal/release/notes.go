@@ -0,0 +1,157 @@
+package release
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Commit is a commit in a release, parsed as a Conventional Commit
+// (https://www.conventionalcommits.org) where it follows the format
+type Commit struct {
+	Hash        string `json:"hash"`
+	Subject     string `json:"subject"`
+	Type        string `json:"type,omitempty"`  // e.g. feat, fix; empty if not conventional
+	Scope       string `json:"scope,omitempty"` // e.g. api in "feat(api): ..."
+	Description string `json:"description"`     // Subject without the type and scope
+	Breaking    bool   `json:"breaking,omitempty"`
+}
+
+var conventionalSubject = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+
+// ParseCommit parses a commit message
+func ParseCommit(hash, subject, body string) Commit {
+	c := Commit{Hash: hash, Subject: subject, Description: subject}
+	if m := conventionalSubject.FindStringSubmatch(subject); m != nil {
+		c.Type = strings.ToLower(m[1])
+		c.Scope = m[2]
+		c.Breaking = m[3] == "!"
+		c.Description = m[4]
+	}
+	if strings.Contains(body, "BREAKING CHANGE:") || strings.Contains(body, "BREAKING-CHANGE:") {
+		c.Breaking = true
+	}
+	return c
+}
+
+// internalTypes are commit types that don't matter to users and are left
+// out of announcements
+var internalTypes = []string{"chore", "ci", "build", "test", "tests", "style", "refactor", "docs"}
+
+// Notes are a release's user-facing changes, grouped for announcements
+type Notes struct {
+	Breaking    []string `json:"breaking,omitempty"`
+	Features    []string `json:"features,omitempty"`
+	Fixes       []string `json:"fixes,omitempty"`
+	Performance []string `json:"performance,omitempty"`
+	Other       []string `json:"other,omitempty"` // Commits not following the convention
+}
+
+// Notes groups the release's commits, oldest first
+func (r *Release) Notes() Notes {
+	var n Notes
+	for i := len(r.Commits) - 1; i >= 0; i-- {
+		c := r.Commits[i]
+		text := Capitalize(c.Description)
+		switch {
+		case c.Breaking:
+			n.Breaking = append(n.Breaking, text)
+		case c.Type == "feat":
+			n.Features = append(n.Features, text)
+		case c.Type == "fix":
+			n.Fixes = append(n.Fixes, text)
+		case c.Type == "perf":
+			n.Performance = append(n.Performance, text)
+		case c.Type == "" || !slices.Contains(internalTypes, c.Type):
+			n.Other = append(n.Other, text)
+		}
+	}
+	return n
+}
+
+// Highlights picks up to n items to lead an announcement with: changelog
+// entries if there are any, else breaking changes, features, performance
+// work and other changes, in that order. Fixes are only highlighted when
+// there is nothing else.
+func (r *Release) Highlights(n int) []string {
+	var items []string
+	for _, line := range strings.Split(r.Changelog, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+			items = append(items, strings.TrimSpace(line[2:]))
+		}
+	}
+	if len(items) == 0 {
+		notes := r.Notes()
+		items = append(items, notes.Breaking...)
+		items = append(items, notes.Features...)
+		items = append(items, notes.Performance...)
+		items = append(items, notes.Other...)
+		if len(items) == 0 {
+			items = notes.Fixes
+		}
+	}
+	if len(items) > n {
+		items = items[:n]
+	}
+	return items
+}
+
+// ChangelogSection returns the section of a Markdown changelog for version,
+// e.g. "## [1.2.0] - 2026-10-01" or "## v1.2.0", up to the next heading of
+// the same or a higher level. A leading "v" is optional on either side.
+func ChangelogSection(changelog, version string) string {
+	want := strings.TrimPrefix(version, "v")
+	lines := strings.Split(changelog, "\n")
+
+	start, level := -1, 0
+	for i, line := range lines {
+		l := headingLevel(line)
+		if l == 0 {
+			continue
+		}
+		if start >= 0 {
+			if l <= level {
+				return strings.TrimSpace(strings.Join(lines[start:i], "\n"))
+			}
+			continue
+		}
+		if headingVersion(line) == want {
+			start, level = i+1, l
+		}
+	}
+	if start < 0 {
+		return ""
+	}
+	return strings.TrimSpace(strings.Join(lines[start:], "\n"))
+}
+
+// headingLevel returns the level of a Markdown ATX heading, or 0
+func headingLevel(line string) int {
+	n := len(line) - len(strings.TrimLeft(line, "#"))
+	if n == 0 || n > 6 || (len(line) > n && line[n] != ' ') {
+		return 0
+	}
+	return n
+}
+
+var headingVersionPattern = regexp.MustCompile(`\[?v?(\d+\.\d+(?:\.\d+)?(?:[-+][0-9A-Za-z.-]+)?)\]?`)
+
+// headingVersion returns the version a changelog heading is for, without a
+// leading "v"
+func headingVersion(line string) string {
+	if m := headingVersionPattern.FindStringSubmatch(line); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// Capitalize upper-cases the first letter of s
+func Capitalize(s string) string {
+	r := []rune(s)
+	if len(r) == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r[0])) + string(r[1:])
+}
@@ -35,8 +35,21 @@ guidelines, and actionable tasks. The output is JSON suitable for LLM consumptio
 
 - ` + "`ygm tasks --json`" + ` - List marketing tasks (filter with --status, --platform)
 - ` + "`ygm tasks create --title \"...\" [--platform X] [--description \"...\"] [--date YYYY-MM-DD] --json`" + ` - Create a task
-- ` + "`ygm tasks update <id> [--title \"...\"] [--description \"...\"] [--status pending|in_progress|completed] --json`" + ` - Update a task
+- ` + "`ygm tasks import <file.csv|json|yml> [--map \"Column=field\"] [--dry-run] --json`" + ` - Create tasks from a file, skipping duplicates
+- ` + "`ygm tasks update <id> [--title \"...\"] [--description \"...\"] [--status pending|in_progress|completed] [--platform X] [--asset-type X] [--date YYYY-MM-DD] [--clear-date] [--clear-description] --json`" + ` - Update a task
+- ` + "`ygm tasks complete <id>... [--url URL] [--published-at DATE] [--force] --json`" + ` - Complete tasks, refusing shared ones and ones missing copy, images or a post date
+- ` + "`ygm tasks update <id>... | --where status=X,platform=Y [fields] --json`" + ` - Update many tasks (one JSON result per line)
+- ` + "`ygm tasks prompt <id> [--kind image|copy|video] [--with-brand] [--format plain|markdown|messages|anthropic]`" + ` - Print a task's generation prompt, optionally composed with brand guidelines
+- ` + "`ygm tasks generate <id> --via \"<command>\" [--with-brand] --yes --json`" + ` - Pipe the copy prompt into a command and save its output as the task's selected copy
+- ` + "`ygm tasks copy <id> [--set -|--set-file F|--list|--select <copy-id>] --json`" + ` - Show, upload or select a task's copy
+- ` + "`ygm tasks images <id> [--download <dir>|--upload <file>...] --json`" + ` - List, download or upload a task's images
+- ` + "`ygm tasks move <id> --before <id>|--after <id>|--top|--bottom|--position N --json`" + ` - Change a task's priority within its status group
+- ` + "`echo \"42 17 8\" | ygm tasks reorder --json`" + ` - Set task order from a list of IDs (most important first)
 - ` + "`ygm tasks discard <id> --json`" + ` - Discard (soft-delete) a task
+- ` + "`ygm tasks --discarded --json`" + ` - List discarded tasks
+- ` + "`ygm tasks restore <id>... --json`" + ` - Restore discarded tasks
+- ` + "`ygm release announce [tag] --dry-run --json`" + ` - Draft launch tasks for a git tag from its commits and CHANGELOG.md (drop --dry-run and add --yes to create them)
+- ` + "`ygm tasks suggestions --json`" + ` - List tasks queued by the git hooks (` + "`ygm hooks install`" + `) for marketing-relevant commits; ` + "`ygm tasks suggestions create [n...] --yes`" + ` creates them
 
 ## When to Use
 
@@ -94,6 +107,12 @@ func InstallLocal() error {
 	return install(cwd)
 }
 
+// InstallAt creates the skill at <dir>/.agents/skills/youve-got-marketing/SKILL.md
+// and symlinks from <dir>/.github/skills/ and <dir>/.claude/skills/.
+func InstallAt(dir string) error {
+	return install(dir)
+}
+
 // RemoveLocal removes .agents/skills/youve-got-marketing and its symlinks
 // from the current directory.
 func RemoveLocal() error {
@@ -101,8 +120,13 @@ func RemoveLocal() error {
 	if err != nil {
 		return fmt.Errorf("cannot determine working directory: %w", err)
 	}
+	return RemoveAt(cwd)
+}
 
-	canonDir, symlinkDirs := skillPaths(cwd)
+// RemoveAt removes <dir>/.agents/skills/youve-got-marketing and its symlinks
+// from <dir>/.github/skills/ and <dir>/.claude/skills/.
+func RemoveAt(dir string) error {
+	canonDir, symlinkDirs := skillPaths(dir)
 
 	// Remove symlinks (only if they are actually symlinks)
 	for _, dir := range symlinkDirs {
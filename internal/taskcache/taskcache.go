@@ -0,0 +1,114 @@
+// Package taskcache remembers which version of each task the CLI last read,
+// so later updates can be made conditional on nobody having changed the task
+// in the meantime.
+package taskcache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+)
+
+// Version identifies the version of a task that was read
+type Version struct {
+	ETag      string    `json:"etag,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Cache holds the last-read versions of one organization's tasks. It is
+// safe for concurrent use.
+type Cache struct {
+	mu       sync.Mutex
+	path     string
+	versions map[int]Version
+	dirty    bool
+}
+
+// Path returns the cache file for an organization
+func Path(org string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine cache directory: %w", err)
+	}
+	return filepath.Join(cacheDir, "ygm", org, "tasks.json"), nil
+}
+
+// Load reads the cache for an organization. A missing or unreadable cache
+// is treated as empty, since it only ever makes updates safer.
+func Load(org string) *Cache {
+	c := &Cache{versions: make(map[int]Version)}
+
+	path, err := Path(org)
+	if err != nil {
+		return c
+	}
+	c.path = path
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		json.Unmarshal(data, &c.versions)
+	}
+	return c
+}
+
+// Remember records the versions of tasks that were just read
+func (c *Cache) Remember(tasks ...api.Task) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, t := range tasks {
+		c.versions[t.ID] = Version{ETag: t.ETag, UpdatedAt: t.UpdatedAt}
+	}
+	c.dirty = true
+}
+
+// Forget drops a task, e.g. after it was discarded
+func (c *Cache) Forget(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.versions, id)
+	c.dirty = true
+}
+
+// Precondition returns the precondition for updating a task based on the
+// version last read, or nil if the task hasn't been read
+func (c *Cache) Precondition(id int) *api.Precondition {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.versions[id]
+	if !ok {
+		return nil
+	}
+	return &api.Precondition{ETag: v.ETag, UnmodifiedSince: v.UpdatedAt}
+}
+
+// Save writes the cache to disk if it changed
+func (c *Cache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.dirty || c.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(c.versions)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	if err := config.WriteFileAtomic(c.path, data, 0600); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
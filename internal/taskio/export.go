@@ -0,0 +1,230 @@
+package taskio
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+// ExportFormats are the formats tasks can be exported to
+var ExportFormats = []string{"csv", "tsv", "markdown", "html"}
+
+// Column is an exportable task field
+type Column struct {
+	Name string
+	// Detailed columns are only returned when fetching a single task
+	Detailed bool
+	Value    func(t api.Task) string
+}
+
+// Columns lists every exportable column in their default order
+var Columns = []Column{
+	{Name: "id", Value: func(t api.Task) string { return strconv.Itoa(t.ID) }},
+	{Name: "title", Value: func(t api.Task) string { return t.Title }},
+	{Name: "status", Value: func(t api.Task) string { return t.Status }},
+	{Name: "platform", Value: func(t api.Task) string { return t.Platform }},
+	{Name: "asset_type", Value: func(t api.Task) string { return t.AssetType }},
+	{Name: "suggested_post_date", Value: func(t api.Task) string { return deref(t.SuggestedPostDate) }},
+	{Name: "description", Value: func(t api.Task) string { return t.Description }},
+	{Name: "position", Value: func(t api.Task) string { return strconv.Itoa(t.Position) }},
+	{Name: "created_at", Value: func(t api.Task) string { return t.CreatedAt.Format("2006-01-02 15:04") }},
+	{Name: "updated_at", Value: func(t api.Task) string { return t.UpdatedAt.Format("2006-01-02 15:04") }},
+	{Name: "image_prompt", Detailed: true, Value: func(t api.Task) string { return t.ImagePrompt }},
+	{Name: "copy_prompt", Detailed: true, Value: func(t api.Task) string { return t.CopyPrompt }},
+	{Name: "video_prompt", Detailed: true, Value: func(t api.Task) string { return t.VideoPrompt }},
+	{Name: "selected_copy", Detailed: true, Value: func(t api.Task) string {
+		if t.SelectedCopy == nil {
+			return ""
+		}
+		return t.SelectedCopy.Content
+	}},
+	{Name: "selected_images_count", Detailed: true, Value: func(t api.Task) string { return strconv.Itoa(t.SelectedImagesCount) }},
+	{Name: "ready_for_completion", Detailed: true, Value: func(t api.Task) string { return strconv.FormatBool(t.ReadyForCompletion) }},
+}
+
+// defaultColumns are exported when no columns are chosen
+var defaultColumns = []string{"id", "title", "status", "platform", "asset_type", "suggested_post_date", "description"}
+
+// ColumnNames returns the names of every exportable column
+func ColumnNames() []string {
+	names := make([]string, len(Columns))
+	for i, c := range Columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// SelectColumns returns the named columns in the given order. With no
+// names it returns the default columns, plus the detailed ones if detailed
+// is set.
+func SelectColumns(names []string, detailed bool) ([]Column, error) {
+	if len(names) == 0 {
+		names = defaultColumns
+		if detailed {
+			for _, c := range Columns {
+				if c.Detailed {
+					names = append(names, c.Name)
+				}
+			}
+		}
+	}
+
+	cols := make([]Column, 0, len(names))
+	for _, name := range names {
+		col, ok := lookupColumn(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown column '%s' (expected one of: %s)", name, strings.Join(ColumnNames(), ", "))
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// NeedsDetails reports whether any column is only available per task
+func NeedsDetails(cols []Column) bool {
+	for _, c := range cols {
+		if c.Detailed {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupColumn(name string) (Column, bool) {
+	for _, c := range Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+// Export writes tasks as a table in the given format
+func Export(w io.Writer, format string, tasks []api.Task, cols []Column) error {
+	switch format {
+	case "csv":
+		return exportDelimited(w, ',', tasks, cols)
+	case "tsv":
+		return exportDelimited(w, '\t', tasks, cols)
+	case "markdown":
+		return exportMarkdown(w, tasks, cols)
+	case "html":
+		return exportHTML(w, tasks, cols)
+	}
+	return fmt.Errorf("unknown format '%s' (expected one of: %s)", format, strings.Join(ExportFormats, ", "))
+}
+
+func exportDelimited(w io.Writer, comma rune, tasks []api.Task, cols []Column) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.Name
+	}
+	cw.Write(header)
+
+	for _, t := range tasks {
+		record := make([]string, len(cols))
+		for i, c := range cols {
+			record[i] = spreadsheetCell(c.Value(t))
+		}
+		cw.Write(record)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// spreadsheetCell stops spreadsheets from evaluating a value as a formula
+// by prefixing those that start like one with a quote. Task text comes from
+// other people, so opening an export must not run it.
+func spreadsheetCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// markdownCell escapes a value for a Markdown table cell, which can't
+// contain pipes or line breaks
+var markdownCell = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func exportMarkdown(w io.Writer, tasks []api.Task, cols []Column) error {
+	var b strings.Builder
+
+	b.WriteString("|")
+	for _, c := range cols {
+		fmt.Fprintf(&b, " %s |", c.Name)
+	}
+	b.WriteString("\n|")
+	for range cols {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, t := range tasks {
+		b.WriteString("|")
+		for _, c := range cols {
+			fmt.Fprintf(&b, " %s |", markdownCell.Replace(c.Value(t)))
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var htmlTemplate = template.Must(template.New("tasks").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Marketing tasks</title>
+<style>
+table { border-collapse: collapse; font-family: sans-serif; font-size: 14px; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; white-space: pre-wrap; }
+th { background: #f4f4f4; }
+</style>
+</head>
+<body>
+<table>
+<thead>
+<tr>{{range .Header}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+func exportHTML(w io.Writer, tasks []api.Task, cols []Column) error {
+	data := struct {
+		Header []string
+		Rows   [][]string
+	}{}
+	for _, c := range cols {
+		data.Header = append(data.Header, c.Name)
+	}
+	for _, t := range tasks {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = c.Value(t)
+		}
+		data.Rows = append(data.Rows, row)
+	}
+	return htmlTemplate.Execute(w, data)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,58 @@
+package taskio
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+func TestExportDelimitedEscapesFormulas(t *testing.T) {
+	cols, err := SelectColumns([]string{"id", "title"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks := []api.Task{
+		{ID: 1, Title: "=HYPERLINK(\"http://evil.test\")"},
+		{ID: 2, Title: "+1 launch"},
+		{ID: 3, Title: "-5% off"},
+		{ID: 4, Title: "@channel"},
+		{ID: 5, Title: "\tindented"},
+		{ID: 6, Title: "\rreturn"},
+		{ID: 7, Title: "Launch = done"},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"csv", "id,title\n" +
+			"1,\"'=HYPERLINK(\"\"http://evil.test\"\")\"\n" +
+			"2,'+1 launch\n" +
+			"3,'-5% off\n" +
+			"4,'@channel\n" +
+			"5,'\tindented\n" +
+			"6,\"'\rreturn\"\n" +
+			"7,Launch = done\n"},
+		{"tsv", "id\ttitle\n" +
+			"1\t\"'=HYPERLINK(\"\"http://evil.test\"\")\"\n" +
+			"2\t'+1 launch\n" +
+			"3\t'-5% off\n" +
+			"4\t'@channel\n" +
+			"5\t\"'\tindented\"\n" +
+			"6\t\"'\rreturn\"\n" +
+			"7\tLaunch = done\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Export(&buf, tt.format, tasks, cols); err != nil {
+				t.Fatalf("Export: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Export =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
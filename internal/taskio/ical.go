@@ -0,0 +1,111 @@
+package taskio
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+)
+
+// ICalOptions describes the calendar that tasks are written to
+type ICalOptions struct {
+	Org     string // Organization slug, used in UIDs and the calendar name
+	Version string // CLI version, used in PRODID
+}
+
+// WriteICal writes tasks with a suggested post date as all-day events of an
+// RFC 5545 calendar. Tasks without a date are left out. Event UIDs are
+// derived from the task ID and org, so calendar apps update events in place
+// when the feed is refreshed.
+func WriteICal(w io.Writer, tasks []api.Task, opts ICalOptions) error {
+	var b strings.Builder
+	line := func(name, value string) {
+		writeFolded(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", fmt.Sprintf("-//Cromulent Consulting//ygm-cli %s//EN", opts.Version))
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escapeText(opts.Org+" marketing tasks"))
+
+	for _, t := range tasks {
+		if t.SuggestedPostDate == nil {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", *t.SuggestedPostDate)
+		if err != nil {
+			continue // Not a date we can place on a calendar
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("task-%d@%s.ygm", t.ID, opts.Org))
+		// DTSTAMP follows the task so unchanged events render identically
+		if t.UpdatedAt.IsZero() {
+			line("DTSTAMP", icalTime(time.Now()))
+		} else {
+			line("DTSTAMP", icalTime(t.UpdatedAt))
+			line("LAST-MODIFIED", icalTime(t.UpdatedAt))
+		}
+		if !t.CreatedAt.IsZero() {
+			line("CREATED", icalTime(t.CreatedAt))
+		}
+		line("DTSTART;VALUE=DATE", day.Format("20060102"))
+		line("DTEND;VALUE=DATE", day.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY", escapeText(t.Title))
+		if t.Description != "" {
+			line("DESCRIPTION", escapeText(t.Description))
+		}
+		line("STATUS", eventStatus(t.Status))
+		if t.Platform != "" {
+			line("CATEGORIES", escapeText(t.Platform))
+		}
+		line("TRANSP", "TRANSPARENT")
+		line("X-YGM-TASK-STATUS", escapeText(t.Status))
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// eventStatus maps a task status to a VEVENT STATUS. Work that hasn't
+// started is tentative; anything in progress or done is confirmed.
+func eventStatus(status string) string {
+	switch status {
+	case "in_progress", "completed", "shared":
+		return "CONFIRMED"
+	}
+	return "TENTATIVE"
+}
+
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT property value (RFC 5545 section 3.3.11)
+var escapeText = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace
+
+// writeFolded writes a content line, folding it at 75 octets without
+// splitting UTF-8 characters (RFC 5545 section 3.1)
+func writeFolded(b *strings.Builder, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		limit = 74 // Continuation lines start with a space
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
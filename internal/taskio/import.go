@@ -0,0 +1,364 @@
+// Package taskio reads and writes marketing tasks in file formats such as
+// CSV, JSON and YAML.
+package taskio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CromulentConsulting/ygm-cli/internal/api"
+	"github.com/CromulentConsulting/ygm-cli/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Import formats
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Fields are the task fields an imported row can set
+var Fields = []string{"title", "description", "platform", "asset_type", "suggested_post_date"}
+
+// Row is one task read from an import file
+type Row struct {
+	Line int
+	Task api.CreateTaskRequest
+}
+
+// RowError is a problem with one row of an import file
+type RowError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportError lists every problem found in an import file
+type ImportError struct {
+	Path   string
+	Errors []RowError
+}
+
+func (e *ImportError) Error() string {
+	lines := make([]string, 0, len(e.Errors)+1)
+	lines = append(lines, fmt.Sprintf("invalid %s:", e.Path))
+	for _, re := range e.Errors {
+		lines = append(lines, fmt.Sprintf("  %s:%d: %s", e.Path, re.Line, re.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DetectFormat returns the import format for a file from its extension
+func DetectFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".json":
+		return FormatJSON, nil
+	case ".yml", ".yaml":
+		return FormatYAML, nil
+	}
+	return "", fmt.Errorf("can't tell the format of %s from its extension; use --format csv, json or yaml", path)
+}
+
+// Read parses tasks from data. mapping renames CSV columns to task fields
+// (column header -> field); it is ignored for other formats. Rows are
+// validated with Validate, and every problem is reported in an *ImportError.
+func Read(path string, data []byte, format string, mapping map[string]string) ([]Row, error) {
+	var rows []Row
+	var problems []RowError
+	var err error
+
+	switch format {
+	case FormatCSV:
+		rows, problems, err = readCSV(data, mapping)
+	case FormatJSON:
+		rows, problems, err = readJSON(data)
+	case FormatYAML:
+		rows, problems, err = readYAML(data)
+	default:
+		return nil, fmt.Errorf("unknown format '%s' (expected csv, json or yaml)", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for _, row := range rows {
+		for _, msg := range Validate(row.Task) {
+			problems = append(problems, RowError{Line: row.Line, Message: msg})
+		}
+	}
+	if len(problems) > 0 {
+		sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+		return nil, &ImportError{Path: path, Errors: problems}
+	}
+	return rows, nil
+}
+
+// Validate checks a task before it is created and returns its problems
+func Validate(t api.CreateTaskRequest) []string {
+	var problems []string
+	if strings.TrimSpace(t.Title) == "" {
+		problems = append(problems, "title is required")
+	}
+	if t.AssetType != "" && !slices.Contains(config.AssetTypes, t.AssetType) {
+		problems = append(problems, fmt.Sprintf("invalid asset_type '%s' (expected one of: %s)", t.AssetType, strings.Join(config.AssetTypes, ", ")))
+	}
+	if t.SuggestedPostDate != nil {
+		if _, err := time.Parse("2006-01-02", *t.SuggestedPostDate); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid suggested_post_date '%s' (expected YYYY-MM-DD)", *t.SuggestedPostDate))
+		}
+	}
+	return problems
+}
+
+// record is a row as written in an import file
+type record struct {
+	Title             string `json:"title" yaml:"title"`
+	Description       string `json:"description" yaml:"description"`
+	Platform          string `json:"platform" yaml:"platform"`
+	AssetType         string `json:"asset_type" yaml:"asset_type"`
+	SuggestedPostDate string `json:"suggested_post_date" yaml:"suggested_post_date"`
+}
+
+func (r record) request() api.CreateTaskRequest {
+	req := api.CreateTaskRequest{
+		Title:       strings.TrimSpace(r.Title),
+		Description: strings.TrimSpace(r.Description),
+		Platform:    strings.TrimSpace(r.Platform),
+		AssetType:   strings.TrimSpace(r.AssetType),
+	}
+	if date := strings.TrimSpace(r.SuggestedPostDate); date != "" {
+		req.SuggestedPostDate = &date
+	}
+	return req
+}
+
+// set assigns a field by name
+func (r *record) set(field, value string) {
+	switch field {
+	case "title":
+		r.Title = value
+	case "description":
+		r.Description = value
+	case "platform":
+		r.Platform = value
+	case "asset_type":
+		r.AssetType = value
+	case "suggested_post_date":
+		r.SuggestedPostDate = value
+	}
+}
+
+// columnAliases are header names recognised without a mapping
+var columnAliases = map[string]string{
+	"date":       "suggested_post_date",
+	"post date":  "suggested_post_date",
+	"asset type": "asset_type",
+}
+
+// readCSV reads a CSV file whose first row is a header. Columns are matched
+// to fields through mapping, then by field name (case-insensitive); other
+// columns are ignored.
+func readCSV(data []byte, mapping map[string]string) ([]Row, []RowError, error) {
+	for column, field := range mapping {
+		if !slices.Contains(Fields, field) {
+			return nil, nil, fmt.Errorf("--map %s=%s: unknown field '%s' (expected one of: %s)", column, field, field, strings.Join(Fields, ", "))
+		}
+	}
+
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := make([]string, len(header))
+	found := map[string]bool{}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		field, ok := mapping[name]
+		if !ok {
+			key := strings.ToLower(name)
+			if slices.Contains(Fields, key) {
+				field = key
+			} else {
+				field = columnAliases[key]
+			}
+		}
+		if field != "" && found[field] {
+			return nil, nil, fmt.Errorf("more than one column maps to %s", field)
+		}
+		if field != "" {
+			found[field] = true
+		}
+		columns[i] = field
+	}
+	if !found["title"] {
+		return nil, nil, fmt.Errorf("no title column in header %q; use --map '<column>=title'", strings.Join(header, ","))
+	}
+
+	var rows []Row
+	var problems []RowError
+	for {
+		values, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				problems = append(problems, RowError{Line: perr.Line, Message: perr.Err.Error()})
+				continue
+			}
+			return nil, nil, err
+		}
+		if isBlank(values) {
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		if len(values) > len(columns) {
+			problems = append(problems, RowError{Line: line, Message: fmt.Sprintf("%d values but the header has %d columns", len(values), len(columns))})
+			continue
+		}
+
+		var rec record
+		for i, value := range values {
+			rec.set(columns[i], value)
+		}
+		rows = append(rows, Row{Line: line, Task: rec.request()})
+	}
+	return rows, problems, nil
+}
+
+// readJSON reads a JSON array of task objects
+func readJSON(data []byte) ([]Row, []RowError, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, nil, fmt.Errorf("expected a JSON array of tasks")
+	}
+
+	var rows []Row
+	var problems []RowError
+	for dec.More() {
+		// InputOffset is just before the element, possibly before whitespace
+		offset := int(dec.InputOffset())
+		line := lineAt(data, skipSpace(data, offset))
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var rec record
+		strict := json.NewDecoder(bytes.NewReader(raw))
+		strict.DisallowUnknownFields()
+		if err := strict.Decode(&rec); err != nil {
+			problems = append(problems, RowError{Line: line, Message: describeJSONError(err)})
+			continue
+		}
+		rows = append(rows, Row{Line: line, Task: rec.request()})
+	}
+	return rows, problems, nil
+}
+
+// readYAML reads a YAML sequence of task mappings
+func readYAML(data []byte) ([]Row, []RowError, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil, nil
+	}
+	list := doc.Content[0]
+	if list.Kind != yaml.SequenceNode {
+		return nil, nil, fmt.Errorf("line %d: expected a list of tasks", list.Line)
+	}
+
+	var rows []Row
+	var problems []RowError
+	for _, item := range list.Content {
+		if item.Kind != yaml.MappingNode {
+			problems = append(problems, RowError{Line: item.Line, Message: "expected a task mapping"})
+			continue
+		}
+
+		ok := true
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key, value := item.Content[i], item.Content[i+1]
+			if !slices.Contains(Fields, key.Value) {
+				problems = append(problems, RowError{Line: key.Line, Message: fmt.Sprintf("unknown field '%s' (expected one of: %s)", key.Value, strings.Join(Fields, ", "))})
+				ok = false
+			} else if value.Kind != yaml.ScalarNode {
+				problems = append(problems, RowError{Line: value.Line, Message: fmt.Sprintf("%s must be a string", key.Value)})
+				ok = false
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		var rec record
+		if err := item.Decode(&rec); err != nil {
+			problems = append(problems, RowError{Line: item.Line, Message: err.Error()})
+			continue
+		}
+		rows = append(rows, Row{Line: item.Line, Task: rec.request()})
+	}
+	return rows, problems, nil
+}
+
+// describeJSONError turns decoding errors into row-level messages
+func describeJSONError(err error) string {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("%s must be a string", typeErr.Field)
+	}
+	msg := err.Error()
+	if field, ok := strings.CutPrefix(msg, "json: unknown field "); ok {
+		return fmt.Sprintf("unknown field %s (expected one of: %s)", field, strings.Join(Fields, ", "))
+	}
+	return msg
+}
+
+func lineAt(data []byte, offset int) int {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func skipSpace(data []byte, offset int) int {
+	for offset < len(data) && strings.ContainsRune(" \t\r\n,", rune(data[offset])) {
+		offset++
+	}
+	return offset
+}
+
+func isBlank(values []string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}